@@ -2,26 +2,33 @@ package main
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	_ "github.com/jackc/pgx/v4/stdlib"
-	"github.com/jmoiron/sqlx"
 	"github.com/opentracing/opentracing-go"
 	"github.com/ozoncp/ocp-team-api/internal/api"
 	"github.com/ozoncp/ocp-team-api/internal/config"
+	"github.com/ozoncp/ocp-team-api/internal/flusher"
+	"github.com/ozoncp/ocp-team-api/internal/health"
 	"github.com/ozoncp/ocp-team-api/internal/kafka"
 	"github.com/ozoncp/ocp-team-api/internal/metrics"
+	"github.com/ozoncp/ocp-team-api/internal/operation"
 	"github.com/ozoncp/ocp-team-api/internal/repo"
+	"github.com/ozoncp/ocp-team-api/internal/saver"
+	"github.com/ozoncp/ocp-team-api/internal/wal"
+	"github.com/ozoncp/ocp-team-api/internal/webhook"
 	desc "github.com/ozoncp/ocp-team-api/pkg/ocp-team-api"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	jaegerMetrics "github.com/uber/jaeger-lib/metrics"
 	"io"
-	"sync/atomic"
 
 	"github.com/rs/zerolog/log"
 	"github.com/uber/jaeger-client-go"
 	jaegercfg "github.com/uber/jaeger-client-go/config"
 	jaegerlog "github.com/uber/jaeger-client-go/log"
 	"golang.org/x/sync/errgroup"
+	"google.golang.org/genproto/googleapis/longrunning"
 	"google.golang.org/grpc"
 	"net"
 	"net/http"
@@ -32,9 +39,27 @@ import (
 )
 
 // createGrpcServer is the method for creating grpc server.
-func createGrpcServer(db *sqlx.DB, producer kafka.Producer) *grpc.Server {
-	grpcServer := grpc.NewServer()
-	desc.RegisterOcpTeamApiServer(grpcServer, api.NewOcpTeamApi(repo.NewRepo(db), producer))
+func createGrpcServer(
+	storage *storage,
+	producer kafka.Producer,
+	teamSaver saver.Saver,
+	replayer flusher.Replayer,
+	operations operation.Registry,
+) *grpc.Server {
+	grpcServer := grpc.NewServer(grpc.UnaryInterceptor(api.SessionInterceptor))
+	desc.RegisterOcpTeamApiServer(
+		grpcServer,
+		api.NewOcpTeamApi(
+			storage.teams,
+			storage.webhooks,
+			storage.deadLetters,
+			producer,
+			teamSaver,
+			replayer,
+			operations,
+		),
+	)
+	longrunning.RegisterOperationsServer(grpcServer, api.NewOperationsServer(operations))
 
 	return grpcServer
 }
@@ -62,21 +87,13 @@ func createHttpGateway(ctx context.Context) *http.Server {
 }
 
 // createStatusServer is the method for creating status server for liveness and readiness probes.
-func createStatusServer() *http.Server {
-	isReady := &atomic.Value{}
-	isReady.Store(false)
-
-	go func() {
-		log.Debug().Msg("Ready probe is negative by default...")
-		time.Sleep(time.Duration(config.GetInstance().Server.StartupTime) * time.Second)
-		isReady.Store(true)
-		log.Debug().Msg("Ready probe is positive.")
-	}()
-
+// The instance is ready when the required dependencies passed the last checks of checker.
+func createStatusServer(checker health.Checker) *http.Server {
 	mux := http.DefaultServeMux
 
-	mux.HandleFunc(config.GetInstance().Status.HealthHandler, health)
-	mux.HandleFunc(config.GetInstance().Status.ReadyHandler, ready(isReady))
+	mux.HandleFunc(config.GetInstance().Status.HealthHandler, healthHandler)
+	mux.HandleFunc(config.GetInstance().Status.ReadyHandler, ready(checker))
+	mux.HandleFunc(config.GetInstance().Status.StatusHandler, status(checker))
 
 	return &http.Server{
 		Addr:    config.GetInstance().Status.Port,
@@ -84,13 +101,32 @@ func createStatusServer() *http.Server {
 	}
 }
 
-func health(w http.ResponseWriter, _ *http.Request) {
+// createHealthChecker is the method for creating the checker of the storage and kafka.
+func createHealthChecker(storage *storage) (health.Checker, io.Closer, error) {
+	kafkaChecker, err := kafka.NewMetadataChecker(config.GetInstance().Kafka)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	checks := append(storage.checks, health.Check{Name: "kafka", Check: kafkaChecker.Check})
+
+	cfg := config.GetInstance().Status
+	checker := health.NewChecker(
+		checks,
+		time.Duration(cfg.CheckInterval)*time.Millisecond,
+		time.Duration(cfg.CheckTimeout)*time.Millisecond,
+	)
+
+	return checker, kafkaChecker, nil
+}
+
+func healthHandler(w http.ResponseWriter, _ *http.Request) {
 	w.WriteHeader(http.StatusOK)
 }
 
-func ready(isReady *atomic.Value) http.HandlerFunc {
+func ready(checker health.Checker) http.HandlerFunc {
 	return func(w http.ResponseWriter, _ *http.Request) {
-		if isReady == nil || !isReady.Load().(bool) {
+		if !checker.Ready() {
 			http.Error(w, http.StatusText(http.StatusServiceUnavailable), http.StatusServiceUnavailable)
 			return
 		}
@@ -98,6 +134,23 @@ func ready(isReady *atomic.Value) http.HandlerFunc {
 	}
 }
 
+// status is the handler writing the state of the dependencies as JSON.
+// The code is 503 when the instance is not ready, as for the readiness probe.
+func status(checker health.Checker) http.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request) {
+		current := checker.Status()
+
+		w.Header().Set("Content-Type", "application/json")
+		if !current.Ready {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+
+		if err := json.NewEncoder(w).Encode(current); err != nil {
+			log.Error().Err(err).Msg("cannot write status")
+		}
+	}
+}
+
 // createMetricsHttpHandler is the method for creating metrics server.
 func createMetricsHttpHandler() *http.Server {
 	mux := http.NewServeMux()
@@ -139,18 +192,198 @@ func createTracer() (opentracing.Tracer, io.Closer, error) {
 	return tracer, closer, nil
 }
 
-// db is the method for connecting to the database.
-func db() (*sqlx.DB, error) {
-	db, err := sqlx.Connect("pgx", config.GetInstance().Database.DSN)
+// createTeamCache is the method for wrapping the team repo of the storage with the cache of lookups.
+// It returns nil if the cache is disabled.
+func createTeamCache(storage *storage) (repo.CachingRepo, error) {
+	cfg := config.GetInstance().Cache
+	if cfg == nil || !cfg.Enabled {
+		return nil, nil
+	}
+
+	var opts []repo.CacheOption
+	if cfg.PrimaryFill {
+		opts = append(opts, repo.WithPrimaryFill())
+	}
+
+	teamCache := repo.NewCachingRepo(storage.teams, cfg.Size, time.Duration(cfg.TTL)*time.Millisecond, opts...)
+	if teamCache == nil {
+		return nil, fmt.Errorf("invalid cache config: size and ttl must be positive")
+	}
+	storage.teams = teamCache
+
+	return teamCache, nil
+}
 
+// createWebhookDispatcher is the method for creating dispatcher of webhook deliveries.
+func createWebhookDispatcher(webhookRepo repo.WebhookRepo) webhook.Dispatcher {
+	cfg := config.GetInstance().Webhook
+
+	return webhook.NewDispatcher(webhookRepo, webhook.Settings{
+		Workers:        cfg.Workers,
+		QueueSize:      cfg.QueueSize,
+		Timeout:        time.Duration(cfg.Timeout) * time.Second,
+		MaxAttempts:    cfg.MaxAttempts,
+		InitialBackoff: time.Duration(cfg.InitialBackoff) * time.Millisecond,
+		MaxBackoff:     time.Duration(cfg.MaxBackoff) * time.Millisecond,
+		DisableAfter:   cfg.DisableAfter,
+	})
+}
+
+// createOperationRegistry is the method for creating registry of asynchronous operations.
+func createOperationRegistry() operation.Registry {
+	cfg := config.GetInstance().Operation
+
+	return operation.NewRegistry(
+		time.Duration(cfg.Retention)*time.Second,
+		time.Duration(cfg.Timeout)*time.Second,
+	)
+}
+
+// createFlusherSinks is the method for creating sinks the saver writes created teams to.
+func createFlusherSinks(producer kafka.Producer) ([]flusher.SinkConfig, error) {
+	cfg := config.GetInstance().Flusher.Sinks
+	if cfg == nil {
+		return nil, nil
+	}
+
+	policy := func(sink *config.FlusherSink) flusher.SinkPolicy {
+		if sink.Required {
+			return flusher.Required
+		}
+		return flusher.BestEffort
+	}
+
+	var sinks []flusher.SinkConfig
+
+	if cfg.Kafka != nil && cfg.Kafka.Enabled {
+		sinks = append(sinks, flusher.SinkConfig{
+			Name:   "kafka",
+			Sink:   flusher.NewProducerSink(producer),
+			Policy: policy(cfg.Kafka),
+		})
+	}
+
+	if cfg.Archive != nil && cfg.Archive.Enabled {
+		archive, err := flusher.NewArchiveSink(cfg.Archive.Path)
+		if err != nil {
+			return nil, err
+		}
+		sinks = append(sinks, flusher.SinkConfig{
+			Name:   "archive",
+			Sink:   archive,
+			Policy: policy(cfg.Archive),
+		})
+	}
+
+	return sinks, nil
+}
+
+// createSaver is the method for creating saver of asynchronously created teams
+// writing them to the database and the sinks and reporting their progress to operations.
+// The replayer it returns writes the created teams of dead letters to the sinks again.
+func createSaver(
+	teamRepo repo.Repo,
+	producer kafka.Producer,
+	operations operation.Registry,
+	deadLetters flusher.RejectHandler,
+) (saver.Saver, flusher.Replayer, error) {
+	saverCfg := config.GetInstance().Saver
+	flusherCfg := config.GetInstance().Flusher
+
+	teamFlusher := flusher.NewFlusher(
+		flusherCfg.ChunkSize,
+		teamRepo,
+		flusher.WithRejectHandler(deadLetters),
+		flusher.WithConcurrency(flusherCfg.Concurrency),
+		flusher.WithBulkThreshold(config.GetInstance().Common.BulkThreshold),
+		flusher.WithRetry(
+			flusherCfg.MaxAttempts,
+			time.Duration(flusherCfg.InitialBackoff)*time.Millisecond,
+			time.Duration(flusherCfg.MaxBackoff)*time.Millisecond,
+		),
+	)
+
+	sinks, err := createFlusherSinks(producer)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return db, nil
+	interval := time.Duration(saverCfg.Interval) * time.Millisecond
+	policies := []saver.FlushPolicy{saver.MaxItems(int(saverCfg.Capacity)), saver.Interval(interval)}
+	if saverCfg.MaxBytes > 0 {
+		policies = append(policies, saver.MaxBytes(saverCfg.MaxBytes))
+	}
+	if saverCfg.MaxAge > 0 {
+		policies = append(policies, saver.MaxAge(time.Duration(saverCfg.MaxAge)*time.Millisecond))
+	}
+
+	opts := []saver.Option{
+		saver.WithQueueSize(saverCfg.QueueSize),
+		saver.WithFlushPolicy(saver.Any(policies...)),
+		saver.WithTracker(operations),
+	}
+
+	if saverCfg.MaxFlushAttempts > 0 {
+		opts = append(opts, saver.WithMaxFlushAttempts(saverCfg.MaxFlushAttempts, deadLetters))
+	}
+
+	switch saverCfg.OverflowPolicy {
+	case "", "block":
+		opts = append(opts, saver.WithOverflowPolicy(saver.Block))
+	case "drop":
+		log.Warn().Msg("saver drops teams when full, their operations stay pending until timeout")
+		opts = append(opts, saver.WithOverflowPolicy(saver.Drop))
+	case "error":
+		opts = append(opts, saver.WithOverflowPolicy(saver.Error))
+	default:
+		return nil, nil, fmt.Errorf("invalid saver config: unknown overflow policy %q", saverCfg.OverflowPolicy)
+	}
+
+	if saverCfg.WAL != nil && saverCfg.WAL.Enabled {
+		walOpts := wal.Options{
+			SyncInterval: time.Duration(saverCfg.WAL.SyncInterval) * time.Millisecond,
+			SegmentSize:  saverCfg.WAL.SegmentSize,
+		}
+
+		switch saverCfg.WAL.SyncPolicy {
+		case "", "always":
+			walOpts.SyncPolicy = wal.SyncAlways
+		case "interval":
+			walOpts.SyncPolicy = wal.SyncInterval
+		case "never":
+			walOpts.SyncPolicy = wal.SyncNever
+		default:
+			return nil, nil, fmt.Errorf("invalid saver config: unknown wal sync policy %q", saverCfg.WAL.SyncPolicy)
+		}
+
+		w, err := wal.Open(saverCfg.WAL.Dir, walOpts)
+		if err != nil {
+			return nil, nil, err
+		}
+		opts = append(opts, saver.WithWAL(w))
+	}
+
+	fanOut := flusher.NewFanOut(teamFlusher, flusherCfg.ChunkSize, sinks...)
+	s := saver.NewSaver(saverCfg.Capacity, fanOut, interval, opts...)
+	if s == nil {
+		return nil, nil, fmt.Errorf("invalid saver config: capacity and interval must be positive")
+	}
+
+	return s, fanOut, nil
 }
 
 func main() {
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "snapshot":
+			runSnapshot(os.Args[2:])
+			return
+		case "migrate":
+			runMigrate(os.Args[2:])
+			return
+		}
+	}
+
 	ctx := context.Background()
 	ctx, cancel := context.WithCancel(ctx)
 	g, ctx := errgroup.WithContext(ctx)
@@ -158,12 +391,21 @@ func main() {
 	interrupt := make(chan os.Signal, 1)
 	signal.Notify(interrupt, os.Interrupt, syscall.SIGTERM)
 
-	db, err := db()
+	storage, err := createStorage()
+	if err != nil {
+		log.Fatal().Msg(err.Error())
+	}
+	defer storage.close()
+
+	storage.teams = repo.NewInstrumentedRepo(
+		storage.teams,
+		repo.WithSlowThreshold(time.Duration(config.GetInstance().Database.SlowQueryThreshold)*time.Millisecond),
+	)
+
+	teamCache, err := createTeamCache(storage)
 	if err != nil {
 		log.Fatal().Msg(err.Error())
 	}
-	log.Info().Msg("connection with DB established")
-	defer db.Close()
 
 	_, closer, err := createTracer()
 	if err != nil {
@@ -176,10 +418,30 @@ func main() {
 		log.Fatal().Msg(err.Error())
 	}
 
-	grpcServer := createGrpcServer(db, kafkaProducer)
+	webhookDispatcher := createWebhookDispatcher(storage.webhooks)
+
+	producer := webhook.NewProducer(kafkaProducer, webhookDispatcher)
+
+	operations := createOperationRegistry()
+	if size, err := storage.deadLetters.CountDeadLetters(ctx); err == nil {
+		metrics.SetDeadLetterSize(size)
+	}
+	deadLetters := flusher.NewDeadLetterHandler(storage.deadLetters)
+	teamSaver, replayer, err := createSaver(storage.teams, producer, operations, deadLetters)
+	if err != nil {
+		log.Fatal().Msg(err.Error())
+	}
+
+	grpcServer := createGrpcServer(storage, producer, teamSaver, replayer, operations)
 	httpGateway := createHttpGateway(ctx)
 	metricsHttpHandler := createMetricsHttpHandler()
-	statusServer := createStatusServer()
+
+	checker, checkerCloser, err := createHealthChecker(storage)
+	if err != nil {
+		log.Fatal().Msg(err.Error())
+	}
+	defer checkerCloser.Close()
+	statusServer := createStatusServer(checker)
 
 	g.Go(func() error {
 		listen, err := net.Listen("tcp", config.GetInstance().Server.GrpcPort)
@@ -199,6 +461,31 @@ func main() {
 		metrics.Register()
 		return metricsHttpHandler.ListenAndServe()
 	})
+	g.Go(func() error {
+		log.Info().Msgf("webhook dispatcher started with %d workers", config.GetInstance().Webhook.Workers)
+		return webhookDispatcher.Run(ctx)
+	})
+	if storage.run != nil {
+		g.Go(func() error {
+			return storage.run(ctx)
+		})
+	}
+	if teamCache != nil && config.GetInstance().Cache.KafkaInvalidation {
+		consumer, err := kafka.NewConsumer()
+		if err != nil {
+			log.Fatal().Msg(err.Error())
+		}
+
+		g.Go(func() error {
+			log.Info().Msg("team cache is invalidated by kafka events")
+			return consumer.Run(ctx, func(message kafka.Message) {
+				teamCache.Invalidate(message.Id)
+			})
+		})
+	}
+	g.Go(func() error {
+		return checker.Run(ctx)
+	})
 	g.Go(func() error {
 		log.Info().Msgf("status server started on port %s", config.GetInstance().Status.Port)
 		return statusServer.ListenAndServe()
@@ -242,6 +529,19 @@ func main() {
 	log.Info().Msg("shutdown grpc server")
 	grpcServer.GracefulStop()
 
+	log.Info().Msg("close saver")
+	unsaved, err := teamSaver.Close(shutdownCtx)
+	if err != nil {
+		log.Error().Err(err).Msg("saver close failed")
+	} else if len(unsaved) > 0 {
+		log.Error().Msgf("%d teams were not saved", len(unsaved))
+		if walCfg := config.GetInstance().Saver.WAL; walCfg == nil || !walCfg.Enabled {
+			for _, team := range unsaved {
+				deadLetters.Reject(shutdownCtx, team, saver.ErrClosed)
+			}
+		}
+	}
+
 	if err = g.Wait(); err != nil && err != http.ErrServerClosed {
 		log.Fatal().Msg(err.Error())
 	}
@@ -0,0 +1,141 @@
+package main
+
+import (
+	"database/sql"
+	"fmt"
+	"github.com/ozoncp/ocp-team-api/internal/config"
+	"github.com/ozoncp/ocp-team-api/migrations"
+	"github.com/pressly/goose/v3"
+	"github.com/rs/zerolog/log"
+	"math"
+	"os"
+)
+
+// schema is the struct binding the database driver to its embedded migrations.
+type schema struct {
+	dialect string
+	dir     string
+}
+
+var (
+	postgresSchema = schema{dialect: "postgres", dir: "."}
+	sqliteSchema   = schema{dialect: "sqlite3", dir: "sqlite"}
+)
+
+// schemaOf is the method for getting migrations of the database driver.
+// It returns error if the driver has no schema.
+func schemaOf(driver string) (schema, error) {
+	switch driver {
+	case "", "postgres":
+		return postgresSchema, nil
+	case "sqlite":
+		return sqliteSchema, nil
+	default:
+		return schema{}, fmt.Errorf("database driver %q has no migrations", driver)
+	}
+}
+
+// use is the method for pointing goose to the migrations of the schema.
+func (s schema) use() error {
+	goose.SetBaseFS(migrations.FS)
+	return goose.SetDialect(s.dialect)
+}
+
+// requiredVersion is the method returning the version of the last migration,
+// which is the schema version the code requires.
+func (s schema) requiredVersion() (int64, error) {
+	all, err := goose.CollectMigrations(s.dir, 0, math.MaxInt64)
+	if err != nil {
+		return 0, err
+	}
+
+	last, err := all.Last()
+	if err != nil {
+		return 0, err
+	}
+
+	return last.Version, nil
+}
+
+// prepare is the method for applying the migrations when autoMigrate is set and checking
+// that the schema version of the database is not older than the code requires.
+func (s schema) prepare(db *sql.DB, autoMigrate bool) error {
+	if err := s.use(); err != nil {
+		return err
+	}
+
+	if autoMigrate {
+		if err := goose.Up(db, s.dir); err != nil {
+			return err
+		}
+	}
+
+	current, err := goose.GetDBVersion(db)
+	if err != nil {
+		return err
+	}
+
+	required, err := s.requiredVersion()
+	if err != nil {
+		return err
+	}
+
+	if current < required {
+		return fmt.Errorf(
+			"database schema version %d is older than required %d, run \"migrate up\" or set database.auto_migrate",
+			current, required,
+		)
+	}
+	log.Info().Msgf("database schema version is %d", current)
+
+	return nil
+}
+
+// runMigrate is the method for the "migrate" subcommand.
+// It applies or rolls back the embedded migrations of the configured database:
+// "up" applies all pending migrations, "down" rolls back the last one, "redo"
+// rolls back and applies the last one again and "status" prints applied migrations.
+func runMigrate(args []string) {
+	if len(args) != 1 {
+		fmt.Fprintln(os.Stderr, "usage: ocp-team-api migrate up|down|status|redo")
+		os.Exit(2)
+	}
+
+	driver := config.GetInstance().Database.Driver
+	s, err := schemaOf(driver)
+	if err != nil {
+		log.Fatal().Msg(err.Error())
+	}
+
+	open := db
+	if s == sqliteSchema {
+		open = sqliteDB
+	}
+
+	migrationDB, err := open()
+	if err != nil {
+		log.Fatal().Msg(err.Error())
+	}
+	defer migrationDB.Close()
+
+	if err = s.use(); err != nil {
+		log.Fatal().Msg(err.Error())
+	}
+
+	switch command := args[0]; command {
+	case "up":
+		err = goose.Up(migrationDB.DB, s.dir)
+	case "down":
+		err = goose.Down(migrationDB.DB, s.dir)
+	case "redo":
+		err = goose.Redo(migrationDB.DB, s.dir)
+	case "status":
+		err = goose.Status(migrationDB.DB, s.dir)
+	default:
+		err = fmt.Errorf("unknown migrate command %q", command)
+	}
+
+	if err != nil {
+		log.Fatal().Msg(err.Error())
+	}
+}
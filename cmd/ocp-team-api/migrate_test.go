@@ -0,0 +1,86 @@
+package main
+
+import (
+	"database/sql"
+	"errors"
+	"github.com/DATA-DOG/go-sqlmock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Schema", func() {
+
+	var (
+		db       *sql.DB
+		mock     sqlmock.Sqlmock
+		required int64
+	)
+
+	BeforeEach(func() {
+		var err error
+		db, mock, err = sqlmock.New()
+		Expect(err).Should(BeNil())
+
+		Expect(postgresSchema.use()).Should(Succeed())
+		required, err = postgresSchema.requiredVersion()
+		Expect(err).Should(BeNil())
+	})
+
+	AfterEach(func() {
+		Expect(mock.ExpectationsWereMet()).Should(Succeed())
+		db.Close()
+	})
+
+	// expectVersion makes the database report the migration history from the newest record.
+	expectVersion := func(records ...interface{}) {
+		rows := sqlmock.NewRows([]string{"version_id", "is_applied"})
+		for i := 0; i < len(records); i += 2 {
+			rows.AddRow(records[i], records[i+1])
+		}
+		mock.ExpectQuery("SELECT version_id, is_applied from goose_db_version ORDER BY id DESC").WillReturnRows(rows)
+	}
+
+	It("requires the version of the last embedded migration", func() {
+		Expect(required).Should(BeNumerically(">", 0))
+		Expect(sqliteSchema.use()).Should(Succeed())
+		Expect(sqliteSchema.requiredVersion()).Should(BeNumerically(">", 0))
+	})
+
+	It("refuses to start when the schema is older than required", func() {
+		expectVersion(required-1, true)
+
+		err := postgresSchema.prepare(db, false)
+		Expect(err).Should(MatchError(ContainSubstring("is older than required")))
+	})
+
+	It("refuses to start when the last migration is rolled back", func() {
+		expectVersion(required, false, required, true)
+
+		Expect(postgresSchema.prepare(db, false)).ShouldNot(Succeed())
+	})
+
+	It("starts with the required schema", func() {
+		expectVersion(required, true)
+
+		Expect(postgresSchema.prepare(db, false)).Should(Succeed())
+	})
+
+	It("starts with the schema newer than required", func() {
+		expectVersion(required+1, true)
+
+		Expect(postgresSchema.prepare(db, false)).Should(Succeed())
+	})
+
+	It("fails when the version cannot be read", func() {
+		mock.ExpectQuery("SELECT version_id").WillReturnRows(
+			sqlmock.NewRows([]string{"version_id", "is_applied"}).RowError(0, errors.New("connection lost")).AddRow(required, true),
+		)
+
+		Expect(postgresSchema.prepare(db, false)).ShouldNot(Succeed())
+	})
+
+	It("refuses unknown drivers", func() {
+		_, err := schemaOf("mysql")
+		Expect(err).ShouldNot(BeNil())
+	})
+})
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"github.com/ozoncp/ocp-team-api/internal/config"
+	"github.com/ozoncp/ocp-team-api/internal/kafka"
+	"github.com/ozoncp/ocp-team-api/internal/snapshot"
+	"github.com/rs/zerolog/log"
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+// runSnapshot is the method for the "snapshot" subcommand.
+// It republishes current state of all teams into kafka as Snapshot events.
+func runSnapshot(args []string) {
+	flags := flag.NewFlagSet("snapshot", flag.ExitOnError)
+	topic := flags.String("topic", config.GetInstance().Kafka.Topic, "topic to publish snapshot events to")
+	pageSize := flags.Uint64("page-size", 500, "number of teams fetched from the database at once")
+	fromId := flags.Uint64("from-id", 0, "first team id to publish (inclusive)")
+	toId := flags.Uint64("to-id", 0, "last team id to publish (inclusive, 0 means no limit)")
+	rate := flags.Float64("rate", 0, "maximum number of published messages per second (0 means no limit)")
+	progressFile := flags.String("progress-file", "", "file for storing progress to resume interrupted run")
+	_ = flags.Parse(args)
+
+	storage, err := createStorage()
+	if err != nil {
+		log.Fatal().Msg(err.Error())
+	}
+	defer storage.close()
+
+	producer, err := kafka.NewProducerWithTopic(*topic)
+	if err != nil {
+		log.Fatal().Msg(err.Error())
+	}
+
+	publisher, err := snapshot.NewPublisher(storage.teams, producer, snapshot.Options{
+		PageSize:     *pageSize,
+		FromId:       *fromId,
+		ToId:         *toId,
+		Rate:         *rate,
+		ProgressFile: *progressFile,
+	})
+	if err != nil {
+		log.Fatal().Msg(err.Error())
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	log.Info().Msgf("publishing snapshot to topic %s", *topic)
+
+	published, err := publisher.Publish(ctx)
+	if err != nil {
+		log.Error().Err(err).Msgf("snapshot interrupted after %d teams", published)
+		return
+	}
+
+	log.Info().Msgf("snapshot finished: %d teams published", published)
+}
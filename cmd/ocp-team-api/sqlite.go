@@ -0,0 +1,7 @@
+//go:build sqlite_fts5
+// +build sqlite_fts5
+
+package main
+
+// The sqlite3 driver needs cgo, so it is linked in only by builds with the sqlite_fts5 tag.
+import _ "github.com/mattn/go-sqlite3"
@@ -0,0 +1,214 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"github.com/ozoncp/ocp-team-api/internal/config"
+	"github.com/ozoncp/ocp-team-api/internal/health"
+	"github.com/ozoncp/ocp-team-api/internal/repo"
+	"github.com/rs/zerolog/log"
+	"time"
+)
+
+// storage is the set of repos selected by database.driver setting.
+type storage struct {
+	teams       repo.Repo
+	webhooks    repo.WebhookRepo
+	deadLetters repo.DeadLetterRepo
+	// checks are the dependencies of the storage reported by the status server.
+	checks []health.Check
+	// run keeps the storage healthy until ctx is done, it is nil if there is nothing to run.
+	run   func(ctx context.Context) error
+	close func()
+}
+
+// createStorage is the method for creating repos of the configured driver:
+// "postgres" (default), "sqlite" or "memory". Data of the memory driver is lost on restart.
+func createStorage() (*storage, error) {
+	switch driver := config.GetInstance().Database.Driver; driver {
+	case "memory":
+		log.Warn().Msg("memory database driver is used, data is lost on restart")
+		return &storage{
+			teams:       repo.NewMemoryRepo(),
+			webhooks:    repo.NewMemoryWebhookRepo(),
+			deadLetters: repo.NewMemoryDeadLetterRepo(),
+			close:       func() {},
+		}, nil
+	case "sqlite":
+		return createSQLiteStorage()
+	case "", "postgres":
+		return createPostgresStorage()
+	default:
+		return nil, fmt.Errorf("invalid database config: unknown driver %q", driver)
+	}
+}
+
+// createPostgresStorage is the method for creating repos connected to the database
+// with reads of teams routed to the replicas.
+func createPostgresStorage() (*storage, error) {
+	db, err := db()
+	if err != nil {
+		return nil, err
+	}
+	log.Info().Msg("connection with DB established")
+
+	if err = postgresSchema.prepare(db.DB, config.GetInstance().Database.AutoMigrate); err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	teamReplicas, replicaDBs, err := replicas()
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	dbCfg := config.GetInstance().Database
+	teamRepo := repo.NewRoutingRepo(
+		repo.NewRepo(db),
+		teamReplicas,
+		repo.WithHealthCheckInterval(time.Duration(dbCfg.HealthCheckInterval)*time.Millisecond),
+		repo.WithReadYourWrites(time.Duration(dbCfg.ReadYourWrites)*time.Millisecond),
+	)
+
+	s := &storage{
+		teams:       teamRepo,
+		webhooks:    repo.NewWebhookRepo(db),
+		deadLetters: repo.NewDeadLetterRepo(db),
+		checks:      []health.Check{{Name: "database", Check: db.PingContext}},
+		close: func() {
+			for _, replicaDB := range replicaDBs {
+				replicaDB.Close()
+			}
+			db.Close()
+		},
+	}
+
+	// Unavailable replicas are ejected by the routing repo, so they do not affect readiness.
+	for _, replica := range teamReplicas {
+		s.checks = append(s.checks, health.Check{Name: "database/" + replica.Name, Check: replica.Ping, Optional: true})
+	}
+
+	if len(teamReplicas) > 0 {
+		s.run = func(ctx context.Context) error {
+			log.Info().Msgf("reads are routed to %d replicas", len(teamReplicas))
+			return teamRepo.Run(ctx)
+		}
+	}
+
+	return s, nil
+}
+
+// createSQLiteStorage is the method for creating repos on the sqlite database file
+// set by database.dsn. Migrations are always applied before the repos are created.
+// Webhooks and dead letters are kept in memory.
+func createSQLiteStorage() (*storage, error) {
+	db, err := sqliteDB()
+	if err != nil {
+		return nil, err
+	}
+
+	if err = sqliteSchema.prepare(db.DB, true); err != nil {
+		db.Close()
+		return nil, err
+	}
+	log.Warn().Msg("webhooks and dead letters of sqlite database driver are lost on restart")
+
+	return &storage{
+		teams:       repo.NewSQLiteRepo(db),
+		webhooks:    repo.NewMemoryWebhookRepo(),
+		deadLetters: repo.NewMemoryDeadLetterRepo(),
+		checks:      []health.Check{{Name: "database", Check: db.PingContext}},
+		close: func() {
+			db.Close()
+		},
+	}, nil
+}
+
+// sqliteDB is the method for opening the sqlite database file.
+func sqliteDB() (*sqlx.DB, error) {
+	if !sqliteRegistered() {
+		return nil, errors.New("invalid database config: sqlite driver is not built in, rebuild with -tags sqlite_fts5")
+	}
+
+	db, err := sqlx.Connect("sqlite3", config.GetInstance().Database.DSN)
+	if err != nil {
+		return nil, err
+	}
+	// SQLite allows the single writer, so the pool is limited to avoid "database is locked" errors.
+	db.SetMaxOpenConns(1)
+
+	return db, nil
+}
+
+// sqliteRegistered is the method for checking that the sqlite3 driver is linked in.
+func sqliteRegistered() bool {
+	for _, driver := range sql.Drivers() {
+		if driver == "sqlite3" {
+			return true
+		}
+	}
+	return false
+}
+
+// db is the method for connecting to the database.
+func db() (*sqlx.DB, error) {
+	db, err := sqlx.Connect("pgx", config.GetInstance().Database.DSN)
+
+	if err != nil {
+		return nil, err
+	}
+	configurePool(db)
+
+	return db, nil
+}
+
+// configurePool is the method for applying the pool settings of the configuration.
+// Zero settings keep the defaults of database/sql.
+func configurePool(db *sqlx.DB) {
+	cfg := config.GetInstance().Database
+
+	if cfg.MaxOpenConns > 0 {
+		db.SetMaxOpenConns(cfg.MaxOpenConns)
+	}
+	if cfg.MaxIdleConns > 0 {
+		db.SetMaxIdleConns(cfg.MaxIdleConns)
+	}
+	if cfg.ConnMaxLifetime > 0 {
+		db.SetConnMaxLifetime(time.Duration(cfg.ConnMaxLifetime) * time.Millisecond)
+	}
+	if cfg.ConnMaxIdleTime > 0 {
+		db.SetConnMaxIdleTime(time.Duration(cfg.ConnMaxIdleTime) * time.Millisecond)
+	}
+}
+
+// replicas is the method for opening connections to the read replicas.
+// Connections are not checked, unavailable replicas are ejected by the health check.
+func replicas() ([]repo.Replica, []*sqlx.DB, error) {
+	dsns := config.GetInstance().Database.Replicas
+	replicas := make([]repo.Replica, 0, len(dsns))
+	dbs := make([]*sqlx.DB, 0, len(dsns))
+
+	for i, dsn := range dsns {
+		db, err := sqlx.Open("pgx", dsn)
+		if err != nil {
+			for _, opened := range dbs {
+				opened.Close()
+			}
+			return nil, nil, err
+		}
+		configurePool(db)
+
+		dbs = append(dbs, db)
+		replicas = append(replicas, repo.Replica{
+			Name: fmt.Sprintf("replica-%d", i),
+			Repo: repo.NewRepo(db),
+			Ping: db.PingContext,
+		})
+	}
+
+	return replicas, dbs, nil
+}
@@ -2,14 +2,18 @@ package api
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/opentracing/opentracing-go"
 	"github.com/ozoncp/ocp-team-api/internal/config"
 	"github.com/ozoncp/ocp-team-api/internal/converter"
+	"github.com/ozoncp/ocp-team-api/internal/flusher"
 	"github.com/ozoncp/ocp-team-api/internal/kafka"
 	"github.com/ozoncp/ocp-team-api/internal/metrics"
 	"github.com/ozoncp/ocp-team-api/internal/models"
+	"github.com/ozoncp/ocp-team-api/internal/operation"
 	"github.com/ozoncp/ocp-team-api/internal/repo"
+	"github.com/ozoncp/ocp-team-api/internal/saver"
 	"github.com/ozoncp/ocp-team-api/internal/utils"
 	desc "github.com/ozoncp/ocp-team-api/pkg/ocp-team-api"
 	"github.com/rs/zerolog/log"
@@ -17,18 +21,46 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+const (
+	// defaultSearchLimit is the size of the page of search hits when the paged request has no limit.
+	defaultSearchLimit = 20
+	// defaultSuggestLimit is the number of suggested names when the request has no limit.
+	defaultSuggestLimit = 5
+)
+
 // api is the struct that implements protobuf-interface.
 type api struct {
 	desc.UnimplementedOcpTeamApiServer
-	repo     repo.Repo
-	producer kafka.Producer
+	repo           repo.Repo
+	webhookRepo    repo.WebhookRepo
+	deadLetterRepo repo.DeadLetterRepo
+	producer       kafka.Producer
+	saver          saver.Saver
+	replayer       flusher.Replayer
+	operations     operation.Registry
 }
 
 // NewOcpTeamApi is the constructor method for api struct.
-func NewOcpTeamApi(repo repo.Repo, producer kafka.Producer) *api {
+// Asynchronously created teams are passed to saver, their progress is tracked by operations.
+// Teams the saver could not persist are managed through deadLetterRepo,
+// those created but not written to the sinks are written to them again by replayer.
+func NewOcpTeamApi(
+	repo repo.Repo,
+	webhookRepo repo.WebhookRepo,
+	deadLetterRepo repo.DeadLetterRepo,
+	producer kafka.Producer,
+	saver saver.Saver,
+	replayer flusher.Replayer,
+	operations operation.Registry,
+) *api {
 	return &api{
-		repo:     repo,
-		producer: producer,
+		repo:           repo,
+		webhookRepo:    webhookRepo,
+		deadLetterRepo: deadLetterRepo,
+		producer:       producer,
+		saver:          saver,
+		replayer:       replayer,
+		operations:     operations,
 	}
 }
 
@@ -44,8 +76,7 @@ func (a *api) CreateTeamV1(
 	}
 	log.Debug().Msgf("CreateTeamV1() was called (name=%s, description=%s)", req.Name, req.Description)
 
-	tracer := opentracing.GlobalTracer()
-	span := tracer.StartSpan("CreateTeamV1")
+	span, ctx := opentracing.StartSpanFromContext(ctx, "CreateTeamV1")
 	defer span.Finish()
 
 	team := models.Team{Name: req.Name, Description: req.Description}
@@ -69,6 +100,8 @@ func (a *api) CreateTeamV1(
 }
 
 // MultiCreateTeamV1 is the method that handles creating multiple teams.
+// Teams are created by batches, or at once through COPY when there are at least
+// common.bulk_threshold of them.
 func (a *api) MultiCreateTeamV1(
 	ctx context.Context,
 	req *desc.MultiCreateTeamV1Request) (*desc.MultiCreateTeamV1Response, error) {
@@ -81,7 +114,7 @@ func (a *api) MultiCreateTeamV1(
 	log.Debug().Msgf("MultiCreateTeamV1() was called with len=%d", len(req.Teams))
 
 	tracer := opentracing.GlobalTracer()
-	parentSpan := tracer.StartSpan("MultiCreateTeamV1")
+	parentSpan, ctx := opentracing.StartSpanFromContext(ctx, "MultiCreateTeamV1")
 	defer parentSpan.Finish()
 
 	teams := make([]models.Team, 0, len(req.Teams))
@@ -92,6 +125,16 @@ func (a *api) MultiCreateTeamV1(
 		})
 	}
 
+	if threshold := config.GetInstance().Common.BulkThreshold; threshold > 0 && len(teams) >= threshold {
+		ids, err := a.repo.BulkCreateTeams(ctx, teams)
+		if err != nil {
+			log.Error().Err(err).Msgf("bulk creation of %d teams failed", len(teams))
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		return &desc.MultiCreateTeamV1Response{Ids: ids}, nil
+	}
+
 	batches := utils.SplitToBulks(teams, config.GetInstance().Common.BatchSize)
 
 	var teamsIds []uint64
@@ -117,6 +160,91 @@ func (a *api) MultiCreateTeamV1(
 	}, nil
 }
 
+// UpsertTeamsV1 is the method that handles creating or updating multiple teams keyed by external id.
+// Batches of teams are upserted in the single transaction, so either all teams are applied or none.
+// Create and Update events are sent after the commit only for teams actually created or updated.
+func (a *api) UpsertTeamsV1(
+	ctx context.Context,
+	req *desc.UpsertTeamsV1Request) (*desc.UpsertTeamsV1Response, error) {
+	metrics.IncTotalRequestsCounter()
+	if err := req.Validate(); err != nil {
+		metrics.IncInvalidRequestsCounter()
+		log.Error().Err(err).Msg("invalid argument")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	log.Debug().Msgf("UpsertTeamsV1() was called with len=%d", len(req.Teams))
+
+	tracer := opentracing.GlobalTracer()
+	parentSpan, ctx := opentracing.StartSpanFromContext(ctx, "UpsertTeamsV1")
+	defer parentSpan.Finish()
+
+	teams := make([]models.Team, 0, len(req.Teams))
+	for _, team := range req.Teams {
+		teams = append(teams, models.Team{
+			Name:        team.Name,
+			Description: team.Description,
+			ExternalId:  team.ExternalId,
+		})
+	}
+
+	if _, err := utils.TeamsToExternalIdMap(teams); err != nil {
+		metrics.IncInvalidRequestsCounter()
+		log.Error().Err(err).Msg("invalid argument")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	results := make([]repo.UpsertResult, 0, len(teams))
+	err := a.repo.WithTx(ctx, func(tx repo.Repo) error {
+		results = results[:0]
+		for i, batch := range utils.SplitToBulks(teams, config.GetInstance().Common.BatchSize) {
+			batchResults, err := tx.UpsertTeams(ctx, batch)
+			if err != nil {
+				return err
+			}
+
+			childSpan := tracer.StartSpan(
+				fmt.Sprintf("batch_index=%d, batch_size=%d", i, len(batch)),
+				opentracing.ChildOf(parentSpan.Context()),
+			)
+			childSpan.Finish()
+
+			results = append(results, batchResults...)
+		}
+		return nil
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("cannot upsert teams")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	response := &desc.UpsertTeamsV1Response{Results: make([]*desc.UpsertTeamsV1Response_Result, 0, len(results))}
+	for i := range results {
+		response.Results = append(response.Results, converter.UpsertResultToDTO(&results[i]))
+		a.sendUpsertEvent(results[i])
+	}
+
+	return response, nil
+}
+
+// sendUpsertEvent is the method that sends the event matching the outcome of upsert.
+func (a *api) sendUpsertEvent(result repo.UpsertResult) {
+	var event kafka.Event
+	switch result.Status {
+	case repo.Created:
+		metrics.IncCreateSuccessCounter()
+		event = kafka.Create
+	case repo.Updated:
+		metrics.IncUpdateSuccessCounter()
+		event = kafka.Update
+	default:
+		return
+	}
+
+	if err := a.producer.Send(kafka.NewMessage(result.Id, event)); err != nil {
+		log.Error().Err(err)
+	}
+}
+
 // GetTeamV1 is the method that handles fetching requested team.
 func (a *api) GetTeamV1(
 	ctx context.Context,
@@ -129,8 +257,7 @@ func (a *api) GetTeamV1(
 	}
 	log.Debug().Msgf("GetTeamV1() was called (id=%d)", req.Id)
 
-	tracer := opentracing.GlobalTracer()
-	span := tracer.StartSpan("GetTeamV1")
+	span, ctx := opentracing.StartSpanFromContext(ctx, "GetTeamV1")
 	defer span.Finish()
 
 	team, err := a.repo.GetTeam(ctx, req.Id)
@@ -157,8 +284,7 @@ func (a *api) ListTeamsV1(
 	}
 	log.Debug().Msgf("ListTeamsV1() was called (limit=%d, offset=%d)", req.Limit, req.Offset)
 
-	tracer := opentracing.GlobalTracer()
-	span := tracer.StartSpan("ListTeamsV1")
+	span, ctx := opentracing.StartSpanFromContext(ctx, "ListTeamsV1")
 	defer span.Finish()
 
 	teams, total, err := a.repo.ListTeams(ctx, req.Limit, req.Offset)
@@ -188,8 +314,7 @@ func (a *api) RemoveTeamV1(
 	}
 	log.Debug().Msgf("RemoveTeamV1() was called (id=%d)", req.Id)
 
-	tracer := opentracing.GlobalTracer()
-	span := tracer.StartSpan("RemoveTeamV1")
+	span, ctx := opentracing.StartSpanFromContext(ctx, "RemoveTeamV1")
 	defer span.Finish()
 
 	err := a.repo.RemoveTeam(ctx, req.Id)
@@ -219,14 +344,17 @@ func (a *api) UpdateTeamV1(
 	}
 	log.Debug().Msgf("UpdateTeamV1() was called (id=%d)", req.Team.Id)
 
-	tracer := opentracing.GlobalTracer()
-	span := tracer.StartSpan("UpdateTeamV1")
+	span, ctx := opentracing.StartSpanFromContext(ctx, "UpdateTeamV1")
 	defer span.Finish()
 
 	team := converter.TeamFromDTO(req.Team)
 
 	err := a.repo.UpdateTeam(ctx, team)
 
+	if errors.Is(err, repo.ErrExternalIdTaken) {
+		return nil, status.Error(codes.AlreadyExists, err.Error())
+	}
+
 	if err != nil {
 		log.Error().Err(err)
 		return nil, status.Error(codes.Internal, err.Error())
@@ -242,6 +370,8 @@ func (a *api) UpdateTeamV1(
 }
 
 // SearchTeamsV1 is the method that handles teams searching.
+// It returns the page of hits ordered by score. Zero limit means defaultSearchLimit
+// for paged requests and all the hits for requests without page token and min score.
 func (a *api) SearchTeamsV1(
 	ctx context.Context,
 	req *desc.SearchTeamV1Request) (*desc.SearchTeamV1Response, error) {
@@ -251,22 +381,68 @@ func (a *api) SearchTeamsV1(
 		log.Error().Err(err).Msg("invalid argument")
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
-	log.Debug().Msg("SearchTeamsV1() was called")
+	log.Debug().Msgf("SearchTeamsV1() was called (limit=%d, min_score=%g)", req.Limit, req.MinScore)
 
-	teams, err := a.repo.SearchTeams(ctx, req.Query, utils.SearchType(req.Type))
-	if err != nil {
-		log.Error().Err(err)
+	limit := req.Limit
+	if limit == 0 && (req.PageToken != "" || req.MinScore > 0) {
+		limit = defaultSearchLimit
+	}
+
+	result, err := a.repo.SearchTeams(ctx, repo.SearchQuery{
+		Text:      req.Query,
+		Type:      utils.SearchType(req.Type),
+		Limit:     limit,
+		PageToken: req.PageToken,
+		MinScore:  float64(req.MinScore),
+	})
+	switch {
+	case errors.Is(err, repo.ErrInvalidPageToken):
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return nil, status.Error(codes.DeadlineExceeded, err.Error())
+	case err != nil:
+		log.Error().Err(err).Msg("cannot search teams")
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	responseTeams := make([]*desc.Team, 0, len(teams))
-	for _, team := range teams {
-		responseTeams = append(responseTeams, &desc.Team{
-			Id:          team.Id,
-			Name:        team.Name,
-			Description: team.Description,
-		})
+	response := &desc.SearchTeamV1Response{
+		Teams:         make([]*desc.Team, 0, len(result.Hits)),
+		Hits:          make([]*desc.SearchTeamV1Response_Hit, 0, len(result.Hits)),
+		Total:         result.Total,
+		NextPageToken: result.NextPageToken,
+	}
+	for i := range result.Hits {
+		hit := &result.Hits[i]
+		team := converter.TeamToDTO(&hit.Team)
+		response.Teams = append(response.Teams, team)
+		response.Hits = append(response.Hits, &desc.SearchTeamV1Response_Hit{Team: team, Score: float32(hit.Score)})
+	}
+
+	return response, nil
+}
+
+// SuggestTeamsV1 is the method that handles completing names of teams for search-as-you-type.
+func (a *api) SuggestTeamsV1(
+	ctx context.Context,
+	req *desc.SuggestTeamsV1Request) (*desc.SuggestTeamsV1Response, error) {
+	metrics.IncTotalRequestsCounter()
+	if err := req.Validate(); err != nil {
+		metrics.IncInvalidRequestsCounter()
+		log.Error().Err(err).Msg("invalid argument")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	log.Debug().Msgf("SuggestTeamsV1() was called (limit=%d)", req.Limit)
+
+	limit := req.Limit
+	if limit == 0 {
+		limit = defaultSuggestLimit
+	}
+
+	names, err := a.repo.SuggestTeams(ctx, req.Prefix, limit)
+	if err != nil {
+		log.Error().Err(err).Msg("cannot suggest teams")
+		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	return &desc.SearchTeamV1Response{Teams: responseTeams}, nil
+	return &desc.SuggestTeamsV1Response{Names: names}, nil
 }
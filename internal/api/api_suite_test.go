@@ -1,6 +1,7 @@
 package api_test
 
 import (
+	"os"
 	"testing"
 
 	. "github.com/onsi/ginkgo"
@@ -8,6 +9,11 @@ import (
 )
 
 func TestApi(t *testing.T) {
+	// config.yml of the handlers is read from the working directory.
+	if err := os.Chdir("testdata"); err != nil {
+		t.Fatal(err)
+	}
+
 	RegisterFailHandler(Fail)
 	RunSpecs(t, "Api Suite")
 }
@@ -2,12 +2,17 @@ package api_test
 
 import (
 	"context"
+	"errors"
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/ozoncp/ocp-team-api/internal/api"
+	"github.com/ozoncp/ocp-team-api/internal/kafka"
 	"github.com/ozoncp/ocp-team-api/internal/mocks"
 	"github.com/ozoncp/ocp-team-api/internal/models"
+	"github.com/ozoncp/ocp-team-api/internal/operation"
+	"github.com/ozoncp/ocp-team-api/internal/repo"
+	"github.com/ozoncp/ocp-team-api/internal/utils"
 	desc "github.com/ozoncp/ocp-team-api/pkg/ocp-team-api"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -20,15 +25,19 @@ var _ = Describe("Api", func() {
 
 		s                 desc.OcpTeamApiServer
 		mockRepo          *mocks.MockRepo
+		mockWebhookRepo   *mocks.MockWebhookRepo
 		mockKafkaProducer *mocks.MockProducer
+		mockSaver         *mocks.MockSaver
 	)
 
 	BeforeEach(func() {
 		ctrl = gomock.NewController(GinkgoT())
 
 		mockRepo = mocks.NewMockRepo(ctrl)
+		mockWebhookRepo = mocks.NewMockWebhookRepo(ctrl)
 		mockKafkaProducer = mocks.NewMockProducer(ctrl)
-		s = api.NewOcpTeamApi(mockRepo, mockKafkaProducer)
+		mockSaver = mocks.NewMockSaver(ctrl)
+		s = api.NewOcpTeamApi(mockRepo, mockWebhookRepo, mocks.NewMockDeadLetterRepo(ctrl), mockKafkaProducer, mockSaver, mocks.NewMockReplayer(ctrl), operation.NewRegistry(0, 0))
 	})
 
 	AfterEach(func() {
@@ -115,6 +124,17 @@ var _ = Describe("Api", func() {
 			Expect(err).Should(BeNil())
 			Expect(actualResponse).Should(Equal(expectedResponse))
 		})
+
+		It("returns error when external id is taken", func() {
+			mockKafkaProducer.EXPECT().Send(gomock.Any()).Times(0)
+			mockRepo.EXPECT().UpdateTeam(gomock.Any(), &models.Team{Id: 1, Name: "Name1", ExternalId: "ext-1"}).
+				Return(repo.ErrExternalIdTaken)
+
+			req := &desc.UpdateTeamV1Request{Team: &desc.Team{Id: 1, Name: "Name1", ExternalId: "ext-1"}}
+
+			_, err := s.UpdateTeamV1(context.Background(), req)
+			Expect(status.Code(err)).Should(Equal(codes.AlreadyExists))
+		})
 	})
 
 	Context("ListTeamsV1()", func() {
@@ -153,4 +173,159 @@ var _ = Describe("Api", func() {
 			Expect(actualResponse).Should(Equal(expectedResponse))
 		})
 	})
+	Context("UpsertTeamsV1()", func() {
+		It("rejects duplicate external ids", func() {
+			mockRepo.EXPECT().UpsertTeams(gomock.Any(), gomock.Any()).Times(0)
+			mockKafkaProducer.EXPECT().Send(gomock.Any()).Times(0)
+
+			req := &desc.UpsertTeamsV1Request{Teams: []*desc.UpsertTeamsV1Request_Team{
+				{ExternalId: "ext-1", Name: "Name", Description: "Description"},
+				{ExternalId: "ext-1", Name: "Other", Description: "Description"},
+			}}
+
+			_, err := s.UpsertTeamsV1(context.Background(), req)
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+
+		It("rejects empty external id", func() {
+			mockRepo.EXPECT().UpsertTeams(gomock.Any(), gomock.Any()).Times(0)
+
+			req := &desc.UpsertTeamsV1Request{Teams: []*desc.UpsertTeamsV1Request_Team{
+				{Name: "Name", Description: "Description"},
+			}}
+
+			_, err := s.UpsertTeamsV1(context.Background(), req)
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+
+		// inTx makes WithTx of mockRepo run fn with mockRepo itself.
+		inTx := func() {
+			mockRepo.EXPECT().WithTx(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, fn func(tx repo.Repo) error, _ ...repo.TxOption) error {
+					return fn(mockRepo)
+				})
+		}
+
+		It("sends events for created and updated teams only", func() {
+			inTx()
+			mockRepo.EXPECT().UpsertTeams(gomock.Any(), gomock.Any()).Return([]repo.UpsertResult{
+				{Id: 1, ExternalId: "ext-1", Status: repo.Created},
+				{Id: 2, ExternalId: "ext-2", Status: repo.Updated},
+			}, nil)
+			mockRepo.EXPECT().UpsertTeams(gomock.Any(), gomock.Any()).Return([]repo.UpsertResult{
+				{Id: 3, ExternalId: "ext-3", Status: repo.Unchanged},
+			}, nil)
+
+			var messages []kafka.Message
+			mockKafkaProducer.EXPECT().Send(gomock.Any()).DoAndReturn(func(message kafka.Message) error {
+				messages = append(messages, message)
+				return nil
+			}).Times(2)
+
+			req := &desc.UpsertTeamsV1Request{Teams: []*desc.UpsertTeamsV1Request_Team{
+				{ExternalId: "ext-1", Name: "Name1"},
+				{ExternalId: "ext-2", Name: "Name2"},
+				{ExternalId: "ext-3", Name: "Name3"},
+			}}
+
+			resp, err := s.UpsertTeamsV1(context.Background(), req)
+			Expect(err).Should(BeNil())
+			Expect(resp.Results).Should(Equal([]*desc.UpsertTeamsV1Response_Result{
+				{Id: 1, ExternalId: "ext-1", Status: desc.UpsertTeamsV1Response_CREATED},
+				{Id: 2, ExternalId: "ext-2", Status: desc.UpsertTeamsV1Response_UPDATED},
+				{Id: 3, ExternalId: "ext-3", Status: desc.UpsertTeamsV1Response_UNCHANGED},
+			}))
+			Expect(messages).Should(Equal([]kafka.Message{
+				kafka.NewMessage(1, kafka.Create),
+				kafka.NewMessage(2, kafka.Update),
+			}))
+		})
+
+		It("sends no events when any batch fails", func() {
+			inTx()
+			mockRepo.EXPECT().UpsertTeams(gomock.Any(), gomock.Any()).Return([]repo.UpsertResult{
+				{Id: 1, ExternalId: "ext-1", Status: repo.Created},
+				{Id: 2, ExternalId: "ext-2", Status: repo.Updated},
+			}, nil)
+			mockRepo.EXPECT().UpsertTeams(gomock.Any(), gomock.Any()).Return(nil, errors.New("db is down"))
+			mockKafkaProducer.EXPECT().Send(gomock.Any()).Times(0)
+
+			req := &desc.UpsertTeamsV1Request{Teams: []*desc.UpsertTeamsV1Request_Team{
+				{ExternalId: "ext-1", Name: "Name1"},
+				{ExternalId: "ext-2", Name: "Name2"},
+				{ExternalId: "ext-3", Name: "Name3"},
+			}}
+
+			resp, err := s.UpsertTeamsV1(context.Background(), req)
+			Expect(status.Code(err)).Should(Equal(codes.Internal))
+			Expect(resp).Should(BeNil())
+		})
+	})
+
+	Context("SearchTeamsV1()", func() {
+		It("returns the page of scored hits", func() {
+			mockRepo.EXPECT().SearchTeams(gomock.Any(), repo.SearchQuery{
+				Text: "core", Type: utils.Plain, Limit: 20, PageToken: "token", MinScore: 0.5,
+			}).Return(&repo.SearchResult{
+				Hits:          []repo.SearchHit{{Team: models.Team{Id: 1, Name: "<b>Core</b>"}, Score: 0.75}},
+				Total:         3,
+				NextPageToken: "next",
+			}, nil)
+
+			req := &desc.SearchTeamV1Request{Query: "core", PageToken: "token", MinScore: 0.5}
+
+			response, err := s.SearchTeamsV1(context.Background(), req)
+			Expect(err).Should(BeNil())
+			Expect(response.Total).Should(Equal(uint64(3)))
+			Expect(response.NextPageToken).Should(Equal("next"))
+			Expect(response.Teams).Should(HaveLen(1))
+			Expect(response.Hits).Should(HaveLen(1))
+			Expect(response.Hits[0].Team.Name).Should(Equal("<b>Core</b>"))
+			Expect(response.Hits[0].Score).Should(Equal(float32(0.75)))
+		})
+
+		It("returns all hits with external ids to requests without paging", func() {
+			mockRepo.EXPECT().SearchTeams(gomock.Any(), repo.SearchQuery{Text: "core", Type: utils.Plain}).Return(
+				&repo.SearchResult{
+					Hits:  []repo.SearchHit{{Team: models.Team{Id: 1, Name: "Core", ExternalId: "ext-1"}, Score: 0.5}},
+					Total: 1,
+				}, nil)
+
+			response, err := s.SearchTeamsV1(context.Background(), &desc.SearchTeamV1Request{Query: "core"})
+			Expect(err).Should(BeNil())
+			Expect(response.Teams[0].ExternalId).Should(Equal("ext-1"))
+			Expect(response.Hits[0].Team.ExternalId).Should(Equal("ext-1"))
+		})
+
+		It("rejects invalid page token", func() {
+			mockRepo.EXPECT().SearchTeams(gomock.Any(), gomock.Any()).Return(nil, repo.ErrInvalidPageToken)
+
+			_, err := s.SearchTeamsV1(context.Background(), &desc.SearchTeamV1Request{Query: "core", PageToken: "invalid"})
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+
+		It("rejects too large limit", func() {
+			mockRepo.EXPECT().SearchTeams(gomock.Any(), gomock.Any()).Times(0)
+
+			_, err := s.SearchTeamsV1(context.Background(), &desc.SearchTeamV1Request{Query: "core", Limit: 101})
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+	})
+
+	Context("SuggestTeamsV1()", func() {
+		It("returns names with the default limit", func() {
+			mockRepo.EXPECT().SuggestTeams(gomock.Any(), "pay", uint64(5)).Return([]string{"Payments"}, nil)
+
+			response, err := s.SuggestTeamsV1(context.Background(), &desc.SuggestTeamsV1Request{Prefix: "pay"})
+			Expect(err).Should(BeNil())
+			Expect(response.Names).Should(Equal([]string{"Payments"}))
+		})
+
+		It("rejects empty prefix", func() {
+			mockRepo.EXPECT().SuggestTeams(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+			_, err := s.SuggestTeamsV1(context.Background(), &desc.SuggestTeamsV1Request{})
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+	})
 })
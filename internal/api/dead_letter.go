@@ -0,0 +1,226 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/opentracing/opentracing-go"
+	"github.com/ozoncp/ocp-team-api/internal/converter"
+	"github.com/ozoncp/ocp-team-api/internal/flusher"
+	"github.com/ozoncp/ocp-team-api/internal/kafka"
+	"github.com/ozoncp/ocp-team-api/internal/metrics"
+	"github.com/ozoncp/ocp-team-api/internal/models"
+	desc "github.com/ozoncp/ocp-team-api/pkg/ocp-team-api"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListDeadLettersV1 is the method that handles fetching the page of teams that could not be persisted.
+func (a *api) ListDeadLettersV1(
+	ctx context.Context,
+	req *desc.ListDeadLettersV1Request) (*desc.ListDeadLettersV1Response, error) {
+	metrics.IncTotalRequestsCounter()
+	if err := req.Validate(); err != nil {
+		metrics.IncInvalidRequestsCounter()
+		log.Error().Err(err).Msg("invalid argument")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	log.Debug().Msgf("ListDeadLettersV1() was called (limit=%d, offset=%d)", req.Limit, req.Offset)
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "ListDeadLettersV1")
+	defer span.Finish()
+
+	letters, total, err := a.deadLetterRepo.ListDeadLetters(ctx, req.Limit, req.Offset)
+	if err != nil {
+		log.Error().Err(err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	metrics.SetDeadLetterSize(total)
+
+	response := &desc.ListDeadLettersV1Response{
+		Total:       total,
+		DeadLetters: make([]*desc.DeadLetter, 0, len(letters)),
+	}
+	for i := range letters {
+		response.DeadLetters = append(response.DeadLetters, converter.DeadLetterToDTO(&letters[i]))
+	}
+
+	return response, nil
+}
+
+// GetDeadLetterV1 is the method that handles fetching the dead letter by id.
+func (a *api) GetDeadLetterV1(
+	ctx context.Context,
+	req *desc.GetDeadLetterV1Request) (*desc.GetDeadLetterV1Response, error) {
+	metrics.IncTotalRequestsCounter()
+	if err := req.Validate(); err != nil {
+		metrics.IncInvalidRequestsCounter()
+		log.Error().Err(err).Msg("invalid argument")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	log.Debug().Msgf("GetDeadLetterV1() was called (id=%d)", req.Id)
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "GetDeadLetterV1")
+	defer span.Finish()
+
+	letter, err := a.getDeadLetter(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &desc.GetDeadLetterV1Response{DeadLetter: converter.DeadLetterToDTO(letter)}, nil
+}
+
+// RetryDeadLetterV1 is the method that handles creating the team of the dead letter,
+// optionally edited by the request. The dead letter is discarded when the team is created,
+// otherwise the edits, the error and the incremented attempt count are stored.
+// The team already created is not created again but written to the sinks it is not written to.
+func (a *api) RetryDeadLetterV1(
+	ctx context.Context,
+	req *desc.RetryDeadLetterV1Request) (*desc.RetryDeadLetterV1Response, error) {
+	metrics.IncTotalRequestsCounter()
+	if err := req.Validate(); err != nil {
+		metrics.IncInvalidRequestsCounter()
+		log.Error().Err(err).Msg("invalid argument")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	log.Debug().Msgf("RetryDeadLetterV1() was called (id=%d)", req.Id)
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "RetryDeadLetterV1")
+	defer span.Finish()
+
+	letter, err := a.getDeadLetter(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if letter.Team.Id != 0 {
+		if req.Name != "" || req.Description != "" {
+			return nil, status.Errorf(
+				codes.FailedPrecondition,
+				"team %d of dead letter %d is already created and cannot be edited", letter.Team.Id, letter.Id,
+			)
+		}
+
+		return a.replayDeadLetter(ctx, letter)
+	}
+
+	if req.Name != "" {
+		letter.Team.Name = req.Name
+	}
+	if req.Description != "" {
+		letter.Team.Description = req.Description
+	}
+
+	team := models.Team{
+		Name:        letter.Team.Name,
+		Description: letter.Team.Description,
+		ExternalId:  letter.Team.ExternalId,
+	}
+	if err = a.repo.CreateTeam(ctx, &team); err != nil {
+		log.Error().Err(err).Msgf("retry of dead letter %d failed", letter.Id)
+
+		letter.Error = err.Error()
+		letter.Attempts++
+		if updateErr := a.deadLetterRepo.UpdateDeadLetter(ctx, letter); updateErr != nil {
+			log.Error().Err(updateErr).Msgf("cannot update dead letter %d", letter.Id)
+		}
+
+		return nil, status.Error(codes.Aborted, err.Error())
+	}
+
+	metrics.IncCreateSuccessCounter()
+	if err = a.producer.Send(kafka.NewMessage(team.Id, kafka.Create)); err != nil {
+		log.Error().Err(err)
+	}
+
+	if _, err = a.deadLetterRepo.RemoveDeadLetter(ctx, letter.Id); err != nil {
+		log.Error().Err(err).Msgf("team %d is created but dead letter %d is not removed", team.Id, letter.Id)
+	}
+	a.refreshDeadLetterSize(ctx)
+
+	return &desc.RetryDeadLetterV1Response{TeamId: team.Id}, nil
+}
+
+// replayDeadLetter is the method that writes the created team of the dead letter to the sinks
+// it is not written to. The dead letter is discarded when all of them succeed,
+// otherwise the sinks still failing, the error and the incremented attempt count are stored.
+func (a *api) replayDeadLetter(
+	ctx context.Context,
+	letter *models.DeadLetter) (*desc.RetryDeadLetterV1Response, error) {
+	if err := a.replayer.Replay(ctx, []models.Team{letter.Team}, letter.Sinks); err != nil {
+		log.Error().Err(err).Msgf("replay of dead letter %d failed", letter.Id)
+
+		var sinkErr *flusher.SinkError
+		if errors.As(err, &sinkErr) {
+			letter.Sinks = sinkErr.Sinks
+		}
+		letter.Error = err.Error()
+		letter.Attempts++
+		if updateErr := a.deadLetterRepo.UpdateDeadLetter(ctx, letter); updateErr != nil {
+			log.Error().Err(updateErr).Msgf("cannot update dead letter %d", letter.Id)
+		}
+
+		return nil, status.Error(codes.Aborted, err.Error())
+	}
+
+	if _, err := a.deadLetterRepo.RemoveDeadLetter(ctx, letter.Id); err != nil {
+		log.Error().Err(err).Msgf("team %d is replayed but dead letter %d is not removed", letter.Team.Id, letter.Id)
+	}
+	a.refreshDeadLetterSize(ctx)
+
+	return &desc.RetryDeadLetterV1Response{TeamId: letter.Team.Id}, nil
+}
+
+// RemoveDeadLetterV1 is the method that handles discarding the dead letter.
+func (a *api) RemoveDeadLetterV1(
+	ctx context.Context,
+	req *desc.RemoveDeadLetterV1Request) (*desc.RemoveDeadLetterV1Response, error) {
+	metrics.IncTotalRequestsCounter()
+	if err := req.Validate(); err != nil {
+		metrics.IncInvalidRequestsCounter()
+		log.Error().Err(err).Msg("invalid argument")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	log.Debug().Msgf("RemoveDeadLetterV1() was called (id=%d)", req.Id)
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "RemoveDeadLetterV1")
+	defer span.Finish()
+
+	found, err := a.deadLetterRepo.RemoveDeadLetter(ctx, req.Id)
+	if err != nil {
+		log.Error().Err(err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "dead letter %d is not found", req.Id)
+	}
+	a.refreshDeadLetterSize(ctx)
+
+	return &desc.RemoveDeadLetterV1Response{}, nil
+}
+
+// getDeadLetter is the method that fetches the dead letter converting errors to grpc status.
+func (a *api) getDeadLetter(ctx context.Context, letterId uint64) (*models.DeadLetter, error) {
+	letter, err := a.deadLetterRepo.GetDeadLetter(ctx, letterId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "dead letter %d is not found", letterId)
+	}
+	if err != nil {
+		log.Error().Err(err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return letter, nil
+}
+
+// refreshDeadLetterSize is the method that updates the dead-letter size metric.
+func (a *api) refreshDeadLetterSize(ctx context.Context) {
+	size, err := a.deadLetterRepo.CountDeadLetters(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("cannot count dead letters")
+		return
+	}
+	metrics.SetDeadLetterSize(size)
+}
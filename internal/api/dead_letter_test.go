@@ -0,0 +1,171 @@
+package api_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/ozoncp/ocp-team-api/internal/api"
+	"github.com/ozoncp/ocp-team-api/internal/flusher"
+	"github.com/ozoncp/ocp-team-api/internal/mocks"
+	"github.com/ozoncp/ocp-team-api/internal/models"
+	"github.com/ozoncp/ocp-team-api/internal/operation"
+	desc "github.com/ozoncp/ocp-team-api/pkg/ocp-team-api"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("Dead letter api", func() {
+
+	var (
+		ctrl *gomock.Controller
+
+		s                  desc.OcpTeamApiServer
+		mockRepo           *mocks.MockRepo
+		mockDeadLetterRepo *mocks.MockDeadLetterRepo
+		mockKafkaProducer  *mocks.MockProducer
+		mockReplayer       *mocks.MockReplayer
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockRepo = mocks.NewMockRepo(ctrl)
+		mockDeadLetterRepo = mocks.NewMockDeadLetterRepo(ctrl)
+		mockKafkaProducer = mocks.NewMockProducer(ctrl)
+		mockReplayer = mocks.NewMockReplayer(ctrl)
+		s = api.NewOcpTeamApi(
+			mockRepo,
+			mocks.NewMockWebhookRepo(ctrl),
+			mockDeadLetterRepo,
+			mockKafkaProducer,
+			mocks.NewMockSaver(ctrl),
+			mockReplayer,
+			operation.NewRegistry(0, 0),
+		)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("ListDeadLettersV1()", func() {
+		It("returns the page with total", func() {
+			mockDeadLetterRepo.EXPECT().ListDeadLetters(gomock.Any(), uint64(10), uint64(0)).Return(
+				[]models.DeadLetter{{Id: 1, Team: models.Team{Name: "Name"}, Error: "invalid", Attempts: 2}},
+				uint64(1), nil)
+
+			resp, err := s.ListDeadLettersV1(context.Background(), &desc.ListDeadLettersV1Request{Limit: 10})
+			Expect(err).Should(BeNil())
+			Expect(resp.Total).Should(Equal(uint64(1)))
+			Expect(resp.DeadLetters).Should(HaveLen(1))
+			Expect(resp.DeadLetters[0].Team.Name).Should(Equal("Name"))
+			Expect(resp.DeadLetters[0].Attempts).Should(Equal(uint32(2)))
+		})
+	})
+
+	Context("GetDeadLetterV1()", func() {
+		It("returns not found for unknown letter", func() {
+			mockDeadLetterRepo.EXPECT().GetDeadLetter(gomock.Any(), uint64(1)).Return(nil, sql.ErrNoRows)
+
+			_, err := s.GetDeadLetterV1(context.Background(), &desc.GetDeadLetterV1Request{Id: 1})
+			Expect(status.Code(err)).Should(Equal(codes.NotFound))
+		})
+	})
+
+	Context("RetryDeadLetterV1()", func() {
+		letter := func() *models.DeadLetter {
+			return &models.DeadLetter{Id: 1, Team: models.Team{Name: "Name", Description: "Desc"}, Attempts: 1}
+		}
+
+		It("creates edited team and discards the letter", func() {
+			mockDeadLetterRepo.EXPECT().GetDeadLetter(gomock.Any(), uint64(1)).Return(letter(), nil)
+			mockRepo.EXPECT().CreateTeam(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, team *models.Team) error {
+					Expect(team.Name).Should(Equal("Fixed"))
+					Expect(team.Description).Should(Equal("Desc"))
+					team.Id = 7
+					return nil
+				})
+			mockKafkaProducer.EXPECT().Send(gomock.Any()).Return(nil)
+			mockDeadLetterRepo.EXPECT().RemoveDeadLetter(gomock.Any(), uint64(1)).Return(true, nil)
+			mockDeadLetterRepo.EXPECT().CountDeadLetters(gomock.Any()).Return(uint64(0), nil)
+
+			resp, err := s.RetryDeadLetterV1(context.Background(), &desc.RetryDeadLetterV1Request{Id: 1, Name: "Fixed"})
+			Expect(err).Should(BeNil())
+			Expect(resp.TeamId).Should(Equal(uint64(7)))
+		})
+
+		It("keeps edits and counts the failed attempt", func() {
+			mockDeadLetterRepo.EXPECT().GetDeadLetter(gomock.Any(), uint64(1)).Return(letter(), nil)
+			mockRepo.EXPECT().CreateTeam(gomock.Any(), gomock.Any()).Return(errors.New("invalid"))
+			mockKafkaProducer.EXPECT().Send(gomock.Any()).Times(0)
+			mockDeadLetterRepo.EXPECT().UpdateDeadLetter(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, letter *models.DeadLetter) error {
+					Expect(letter.Team.Name).Should(Equal("Fixed"))
+					Expect(letter.Error).Should(Equal("invalid"))
+					Expect(letter.Attempts).Should(Equal(uint32(2)))
+					return nil
+				})
+
+			_, err := s.RetryDeadLetterV1(context.Background(), &desc.RetryDeadLetterV1Request{Id: 1, Name: "Fixed"})
+			Expect(status.Code(err)).Should(Equal(codes.Aborted))
+		})
+
+		Context("when team is already created", func() {
+			created := func() *models.DeadLetter {
+				created := letter()
+				created.Team.Id = 7
+				created.Sinks = []string{"kafka", "search"}
+				return created
+			}
+
+			It("replays failed sinks instead of creating the team", func() {
+				mockDeadLetterRepo.EXPECT().GetDeadLetter(gomock.Any(), uint64(1)).Return(created(), nil)
+				mockRepo.EXPECT().CreateTeam(gomock.Any(), gomock.Any()).Times(0)
+				mockReplayer.EXPECT().Replay(gomock.Any(), []models.Team{created().Team}, []string{"kafka", "search"}).Return(nil)
+				mockDeadLetterRepo.EXPECT().RemoveDeadLetter(gomock.Any(), uint64(1)).Return(true, nil)
+				mockDeadLetterRepo.EXPECT().CountDeadLetters(gomock.Any()).Return(uint64(0), nil)
+
+				resp, err := s.RetryDeadLetterV1(context.Background(), &desc.RetryDeadLetterV1Request{Id: 1})
+				Expect(err).Should(BeNil())
+				Expect(resp.TeamId).Should(Equal(uint64(7)))
+			})
+
+			It("keeps sinks still failing and counts the failed attempt", func() {
+				sinkErr := &flusher.SinkError{Sinks: []string{"search"}, Err: errors.New("unavailable")}
+				mockDeadLetterRepo.EXPECT().GetDeadLetter(gomock.Any(), uint64(1)).Return(created(), nil)
+				mockReplayer.EXPECT().Replay(gomock.Any(), gomock.Any(), gomock.Any()).Return(sinkErr)
+				mockDeadLetterRepo.EXPECT().UpdateDeadLetter(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, letter *models.DeadLetter) error {
+						Expect(letter.Sinks).Should(Equal([]string{"search"}))
+						Expect(letter.Error).Should(Equal(sinkErr.Error()))
+						Expect(letter.Attempts).Should(Equal(uint32(2)))
+						return nil
+					})
+
+				_, err := s.RetryDeadLetterV1(context.Background(), &desc.RetryDeadLetterV1Request{Id: 1})
+				Expect(status.Code(err)).Should(Equal(codes.Aborted))
+			})
+
+			It("refuses to edit the team", func() {
+				mockDeadLetterRepo.EXPECT().GetDeadLetter(gomock.Any(), uint64(1)).Return(created(), nil)
+				mockReplayer.EXPECT().Replay(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+				_, err := s.RetryDeadLetterV1(context.Background(), &desc.RetryDeadLetterV1Request{Id: 1, Name: "Fixed"})
+				Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+			})
+		})
+	})
+
+	Context("RemoveDeadLetterV1()", func() {
+		It("returns not found for unknown letter", func() {
+			mockDeadLetterRepo.EXPECT().RemoveDeadLetter(gomock.Any(), uint64(1)).Return(false, nil)
+
+			_, err := s.RemoveDeadLetterV1(context.Background(), &desc.RemoveDeadLetterV1Request{Id: 1})
+			Expect(status.Code(err)).Should(Equal(codes.NotFound))
+		})
+	})
+})
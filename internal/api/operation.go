@@ -0,0 +1,254 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"github.com/opentracing/opentracing-go"
+	"github.com/ozoncp/ocp-team-api/internal/converter"
+	"github.com/ozoncp/ocp-team-api/internal/metrics"
+	"github.com/ozoncp/ocp-team-api/internal/models"
+	"github.com/ozoncp/ocp-team-api/internal/operation"
+	"github.com/ozoncp/ocp-team-api/internal/saver"
+	desc "github.com/ozoncp/ocp-team-api/pkg/ocp-team-api"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/genproto/googleapis/longrunning"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strings"
+)
+
+// CreateTeamAsyncV1 is the method that handles enqueueing new team for creation.
+// It returns the operation to poll for the id of the team.
+func (a *api) CreateTeamAsyncV1(
+	ctx context.Context,
+	req *desc.CreateTeamV1Request) (*longrunning.Operation, error) {
+	metrics.IncTotalRequestsCounter()
+	if err := req.Validate(); err != nil {
+		metrics.IncInvalidRequestsCounter()
+		log.Error().Err(err).Msg("invalid argument")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	log.Debug().Msgf("CreateTeamAsyncV1() was called (name=%s, description=%s)", req.Name, req.Description)
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "CreateTeamAsyncV1")
+	defer span.Finish()
+
+	return a.createAsync(ctx, []*desc.CreateTeamV1Request{req})
+}
+
+// MultiCreateTeamAsyncV1 is the method that handles enqueueing multiple teams for creation.
+// It returns the operation to poll for the ids of the teams.
+func (a *api) MultiCreateTeamAsyncV1(
+	ctx context.Context,
+	req *desc.MultiCreateTeamV1Request) (*longrunning.Operation, error) {
+	metrics.IncTotalRequestsCounter()
+	if err := req.Validate(); err != nil {
+		metrics.IncInvalidRequestsCounter()
+		log.Error().Err(err).Msg("invalid argument")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	log.Debug().Msgf("MultiCreateTeamAsyncV1() was called with len=%d", len(req.Teams))
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MultiCreateTeamAsyncV1")
+	defer span.Finish()
+
+	return a.createAsync(ctx, req.Teams)
+}
+
+// createAsync is the method that starts the operation and passes the teams to the saver.
+// When the saver refuses a team, the rest of the teams fail the operation. An error is
+// returned only if none of the teams is enqueued.
+func (a *api) createAsync(ctx context.Context, reqs []*desc.CreateTeamV1Request) (*longrunning.Operation, error) {
+	op := a.operations.Start(len(reqs))
+
+	for i, req := range reqs {
+		team := models.Team{
+			Name:        req.Name,
+			Description: req.Description,
+		}
+
+		err := a.saver.SaveTracked(ctx, team, models.OperationRef{Id: op.Id, Index: i})
+		if err == nil {
+			continue
+		}
+
+		log.Error().Err(err).Msgf("cannot enqueue team of operation %s", op.Id)
+		saveErr := status.Error(saveErrorCode(err), err.Error())
+		for j := i; j < len(reqs); j++ {
+			a.operations.Fail(models.OperationRef{Id: op.Id, Index: j}, saveErr)
+		}
+
+		if i == 0 {
+			return nil, saveErr
+		}
+		break
+	}
+
+	return operationResponse(a.operations, op.Id)
+}
+
+// GetOperationV1 is the method that handles fetching the state of the operation.
+func (a *api) GetOperationV1(
+	ctx context.Context,
+	req *desc.GetOperationV1Request) (*longrunning.Operation, error) {
+	metrics.IncTotalRequestsCounter()
+	if err := req.Validate(); err != nil {
+		metrics.IncInvalidRequestsCounter()
+		log.Error().Err(err).Msg("invalid argument")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	log.Debug().Msgf("GetOperationV1() was called (name=%s)", req.Name)
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "GetOperationV1")
+	defer span.Finish()
+
+	return operationResponse(a.operations, strings.TrimPrefix(req.Name, "operations/"))
+}
+
+// ListOperationsV1 is the method that handles fetching operations from the newest to the oldest.
+func (a *api) ListOperationsV1(
+	ctx context.Context,
+	req *desc.ListOperationsV1Request) (*desc.ListOperationsV1Response, error) {
+	metrics.IncTotalRequestsCounter()
+	if err := req.Validate(); err != nil {
+		metrics.IncInvalidRequestsCounter()
+		log.Error().Err(err).Msg("invalid argument")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	log.Debug().Msgf("ListOperationsV1() was called (filter=%s, page_size=%d)", req.Filter, req.PageSize)
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "ListOperationsV1")
+	defer span.Finish()
+
+	operations, nextPageToken, err := listOperations(a.operations, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &desc.ListOperationsV1Response{
+		Operations:    operations,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// operationsServer is the struct that implements google.longrunning.Operations interface
+// on top of the registry of asynchronous operations, so standard clients can poll them.
+// Operations can be neither cancelled nor deleted, waiting is left to polling.
+type operationsServer struct {
+	longrunning.UnimplementedOperationsServer
+	operations operation.Registry
+}
+
+// NewOperationsServer is the constructor method for operationsServer struct.
+func NewOperationsServer(operations operation.Registry) *operationsServer {
+	return &operationsServer{operations: operations}
+}
+
+// GetOperation is the method that handles fetching the state of the operation.
+func (s *operationsServer) GetOperation(
+	ctx context.Context,
+	req *longrunning.GetOperationRequest) (*longrunning.Operation, error) {
+	metrics.IncTotalRequestsCounter()
+	if err := (&desc.GetOperationV1Request{Name: req.Name}).Validate(); err != nil {
+		metrics.IncInvalidRequestsCounter()
+		log.Error().Err(err).Msg("invalid argument")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	log.Debug().Msgf("GetOperation() was called (name=%s)", req.Name)
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "GetOperation")
+	defer span.Finish()
+
+	return operationResponse(s.operations, strings.TrimPrefix(req.Name, "operations/"))
+}
+
+// ListOperations is the method that handles fetching operations from the newest to the oldest.
+// Name of the collection is either empty or "operations".
+func (s *operationsServer) ListOperations(
+	ctx context.Context,
+	req *longrunning.ListOperationsRequest) (*longrunning.ListOperationsResponse, error) {
+	metrics.IncTotalRequestsCounter()
+	listReq := &desc.ListOperationsV1Request{
+		Filter:    req.Filter,
+		PageSize:  req.PageSize,
+		PageToken: req.PageToken,
+	}
+	err := listReq.Validate()
+	if err == nil && req.Name != "" && req.Name != "operations" {
+		err = errors.New("invalid ListOperationsRequest.Name: value must be empty or \"operations\"")
+	}
+	if err != nil {
+		metrics.IncInvalidRequestsCounter()
+		log.Error().Err(err).Msg("invalid argument")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	log.Debug().Msgf("ListOperations() was called (filter=%s, page_size=%d)", req.Filter, req.PageSize)
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "ListOperations")
+	defer span.Finish()
+
+	operations, nextPageToken, err := listOperations(s.operations, listReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &longrunning.ListOperationsResponse{
+		Operations:    operations,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// listOperations is the method that converts the page of operations matching the request.
+func listOperations(
+	registry operation.Registry,
+	req *desc.ListOperationsV1Request) ([]*longrunning.Operation, string, error) {
+	var done *bool
+	if req.Filter != "" {
+		value := req.Filter == "done=true"
+		done = &value
+	}
+
+	operations, nextPageToken, err := registry.List(done, req.PageToken, int(req.PageSize))
+	if err != nil {
+		return nil, "", status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	dtos := make([]*longrunning.Operation, 0, len(operations))
+	for i := range operations {
+		dto, err := converter.OperationToDTO(&operations[i])
+		if err != nil {
+			log.Error().Err(err)
+			return nil, "", status.Error(codes.Internal, err.Error())
+		}
+		dtos = append(dtos, dto)
+	}
+
+	return dtos, nextPageToken, nil
+}
+
+// operationResponse is the method that converts the current state of the operation to the response.
+func operationResponse(registry operation.Registry, id string) (*longrunning.Operation, error) {
+	op, ok := registry.Get(id)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "operation %s is not found", id)
+	}
+
+	dto, err := converter.OperationToDTO(&op)
+	if err != nil {
+		log.Error().Err(err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return dto, nil
+}
+
+func saveErrorCode(err error) codes.Code {
+	switch {
+	case errors.Is(err, saver.ErrQueueFull):
+		return codes.ResourceExhausted
+	case errors.Is(err, saver.ErrClosed):
+		return codes.Unavailable
+	default:
+		return status.FromContextError(err).Code()
+	}
+}
@@ -0,0 +1,221 @@
+package api_test
+
+import (
+	"context"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/ozoncp/ocp-team-api/internal/api"
+	"github.com/ozoncp/ocp-team-api/internal/mocks"
+	"github.com/ozoncp/ocp-team-api/internal/models"
+	"github.com/ozoncp/ocp-team-api/internal/operation"
+	"github.com/ozoncp/ocp-team-api/internal/saver"
+	desc "github.com/ozoncp/ocp-team-api/pkg/ocp-team-api"
+	"google.golang.org/genproto/googleapis/longrunning"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("Operation api", func() {
+
+	var (
+		ctrl *gomock.Controller
+
+		s          desc.OcpTeamApiServer
+		mockSaver  *mocks.MockSaver
+		operations operation.Registry
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockSaver = mocks.NewMockSaver(ctrl)
+		operations = operation.NewRegistry(0, 0)
+		s = api.NewOcpTeamApi(
+			mocks.NewMockRepo(ctrl),
+			mocks.NewMockWebhookRepo(ctrl),
+			mocks.NewMockDeadLetterRepo(ctrl),
+			mocks.NewMockProducer(ctrl),
+			mockSaver,
+			mocks.NewMockReplayer(ctrl),
+			operations,
+		)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("CreateTeamAsyncV1()", func() {
+		It("enqueues the team and returns pending operation", func() {
+			var saved models.OperationRef
+			mockSaver.EXPECT().SaveTracked(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, _ models.Team, ref models.OperationRef) error {
+					saved = ref
+					return nil
+				})
+
+			op, err := s.CreateTeamAsyncV1(context.Background(), &desc.CreateTeamV1Request{Name: "Name"})
+			Expect(err).Should(BeNil())
+			Expect(op.Done).Should(BeFalse())
+			Expect(op.Name).Should(Equal("operations/" + saved.Id))
+
+			metadata := &desc.OperationMetadataV1{}
+			Expect(op.Metadata.UnmarshalTo(metadata)).Should(Succeed())
+			Expect(metadata.State).Should(Equal(desc.OperationMetadataV1_PENDING))
+			Expect(metadata.Total).Should(Equal(uint32(1)))
+		})
+
+		It("returns error when the saver is full", func() {
+			mockSaver.EXPECT().SaveTracked(gomock.Any(), gomock.Any(), gomock.Any()).Return(saver.ErrQueueFull)
+
+			_, err := s.CreateTeamAsyncV1(context.Background(), &desc.CreateTeamV1Request{Name: "Name"})
+			Expect(status.Code(err)).Should(Equal(codes.ResourceExhausted))
+		})
+
+		It("returns error on invalid request", func() {
+			_, err := s.CreateTeamAsyncV1(context.Background(), &desc.CreateTeamV1Request{Name: "N"})
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+	})
+
+	Context("MultiCreateTeamAsyncV1()", func() {
+		req := &desc.MultiCreateTeamV1Request{Teams: []*desc.CreateTeamV1Request{
+			{Name: "Name1"}, {Name: "Name2"}, {Name: "Name3"},
+		}}
+
+		It("reports created ids in request order", func() {
+			var refs []models.OperationRef
+			mockSaver.EXPECT().SaveTracked(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, _ models.Team, ref models.OperationRef) error {
+					refs = append(refs, ref)
+					return nil
+				}).Times(3)
+
+			op, err := s.MultiCreateTeamAsyncV1(context.Background(), req)
+			Expect(err).Should(BeNil())
+
+			operations.Complete(refs[2], 30)
+			operations.Complete(refs[0], 10)
+			operations.Complete(refs[1], 20)
+
+			op, err = s.GetOperationV1(context.Background(), &desc.GetOperationV1Request{Name: op.Name})
+			Expect(err).Should(BeNil())
+			Expect(op.Done).Should(BeTrue())
+
+			response := &desc.MultiCreateTeamV1Response{}
+			Expect(op.GetResponse().UnmarshalTo(response)).Should(Succeed())
+			Expect(response.Ids).Should(Equal([]uint64{10, 20, 30}))
+		})
+
+		It("fails the rest of the teams when the saver refuses one", func() {
+			gomock.InOrder(
+				mockSaver.EXPECT().SaveTracked(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil),
+				mockSaver.EXPECT().SaveTracked(gomock.Any(), gomock.Any(), gomock.Any()).Return(saver.ErrClosed),
+			)
+
+			op, err := s.MultiCreateTeamAsyncV1(context.Background(), req)
+			Expect(err).Should(BeNil())
+			Expect(op.Done).Should(BeFalse())
+
+			metadata := &desc.OperationMetadataV1{}
+			Expect(op.Metadata.UnmarshalTo(metadata)).Should(Succeed())
+			Expect(metadata.Failed).Should(Equal(uint32(2)))
+		})
+	})
+
+	Context("GetOperationV1()", func() {
+		It("returns failed operation with error", func() {
+			op := operations.Start(1)
+			operations.Fail(models.OperationRef{Id: op.Id}, status.Error(codes.InvalidArgument, "invalid"))
+
+			response, err := s.GetOperationV1(context.Background(), &desc.GetOperationV1Request{Name: op.Name()})
+			Expect(err).Should(BeNil())
+			Expect(response.Done).Should(BeTrue())
+			Expect(response.GetError().Code).Should(Equal(int32(codes.InvalidArgument)))
+		})
+
+		It("returns not found for unknown operation", func() {
+			_, err := s.GetOperationV1(context.Background(), &desc.GetOperationV1Request{Name: "operations/unknown"})
+			Expect(status.Code(err)).Should(Equal(codes.NotFound))
+		})
+
+		It("returns error on malformed name", func() {
+			_, err := s.GetOperationV1(context.Background(), &desc.GetOperationV1Request{Name: "unknown"})
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+	})
+
+	Context("ListOperationsV1()", func() {
+		It("filters pending operations", func() {
+			pending := operations.Start(1)
+			done := operations.Start(1)
+			operations.Complete(models.OperationRef{Id: done.Id}, 1)
+
+			response, err := s.ListOperationsV1(context.Background(), &desc.ListOperationsV1Request{Filter: "done=false"})
+			Expect(err).Should(BeNil())
+			Expect(response.Operations).Should(HaveLen(1))
+			Expect(response.Operations[0].Name).Should(Equal(pending.Name()))
+		})
+
+		It("returns error on malformed page token", func() {
+			_, err := s.ListOperationsV1(context.Background(), &desc.ListOperationsV1Request{PageToken: "token"})
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+	})
+
+	Context("Operations service", func() {
+		var lro longrunning.OperationsServer
+
+		BeforeEach(func() {
+			lro = api.NewOperationsServer(operations)
+		})
+
+		It("returns the operation of the registry", func() {
+			op := operations.Start(1)
+			operations.Complete(models.OperationRef{Id: op.Id}, 10)
+
+			response, err := lro.GetOperation(context.Background(), &longrunning.GetOperationRequest{Name: op.Name()})
+			Expect(err).Should(BeNil())
+			Expect(response.Done).Should(BeTrue())
+
+			ids := &desc.MultiCreateTeamV1Response{}
+			Expect(response.GetResponse().UnmarshalTo(ids)).Should(Succeed())
+			Expect(ids.Ids).Should(Equal([]uint64{10}))
+		})
+
+		It("returns not found for unknown operation", func() {
+			_, err := lro.GetOperation(context.Background(), &longrunning.GetOperationRequest{Name: "operations/unknown"})
+			Expect(status.Code(err)).Should(Equal(codes.NotFound))
+		})
+
+		It("filters done operations", func() {
+			operations.Start(1)
+			done := operations.Start(1)
+			operations.Complete(models.OperationRef{Id: done.Id}, 1)
+
+			response, err := lro.ListOperations(context.Background(), &longrunning.ListOperationsRequest{
+				Name:   "operations",
+				Filter: "done=true",
+			})
+			Expect(err).Should(BeNil())
+			Expect(response.Operations).Should(HaveLen(1))
+			Expect(response.Operations[0].Name).Should(Equal(done.Name()))
+		})
+
+		It("returns error on unknown collection or filter", func() {
+			_, err := lro.ListOperations(context.Background(), &longrunning.ListOperationsRequest{Name: "users/1/operations"})
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+
+			_, err = lro.ListOperations(context.Background(), &longrunning.ListOperationsRequest{Filter: "name=x"})
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+
+		It("does not cancel operations", func() {
+			op := operations.Start(1)
+
+			_, err := lro.CancelOperation(context.Background(), &longrunning.CancelOperationRequest{Name: op.Name()})
+			Expect(status.Code(err)).Should(Equal(codes.Unimplemented))
+		})
+	})
+})
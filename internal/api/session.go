@@ -0,0 +1,29 @@
+package api
+
+import (
+	"context"
+	"github.com/ozoncp/ocp-team-api/internal/repo"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// SessionMetadataKey is the grpc metadata key of the session id the caller may send
+// to read its own writes. Through the http gateway it is sent as Grpc-Metadata-X-Session-Id header.
+const SessionMetadataKey = "x-session-id"
+
+// SessionInterceptor is the grpc interceptor that passes the session id
+// of the caller to the repo layer.
+func SessionInterceptor(
+	ctx context.Context,
+	req interface{},
+	_ *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(SessionMetadataKey); len(values) > 0 && values[0] != "" {
+			ctx = repo.WithSession(ctx, values[0])
+		}
+	}
+
+	return handler(ctx, req)
+}
@@ -0,0 +1,116 @@
+package api
+
+import (
+	"context"
+	"github.com/opentracing/opentracing-go"
+	"github.com/ozoncp/ocp-team-api/internal/converter"
+	"github.com/ozoncp/ocp-team-api/internal/metrics"
+	"github.com/ozoncp/ocp-team-api/internal/models"
+	desc "github.com/ozoncp/ocp-team-api/pkg/ocp-team-api"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CreateWebhookV1 is the method that handles registering new webhook subscription.
+func (a *api) CreateWebhookV1(
+	ctx context.Context,
+	req *desc.CreateWebhookV1Request) (*desc.CreateWebhookV1Response, error) {
+	metrics.IncTotalRequestsCounter()
+	if err := req.Validate(); err != nil {
+		metrics.IncInvalidRequestsCounter()
+		log.Error().Err(err).Msg("invalid argument")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	log.Debug().Msgf("CreateWebhookV1() was called (url=%s, events=%v)", req.Url, req.Events)
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "CreateWebhookV1")
+	defer span.Finish()
+
+	webhook := models.Webhook{URL: req.Url, Events: req.Events, Secret: req.Secret}
+
+	if err := a.webhookRepo.CreateWebhook(ctx, &webhook); err != nil {
+		log.Error().Err(err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	log.Debug().Msgf("new webhook was created successfully with id=%d", webhook.Id)
+
+	return &desc.CreateWebhookV1Response{Id: webhook.Id}, nil
+}
+
+// ListWebhooksV1 is the method that handles fetching all webhook subscriptions.
+func (a *api) ListWebhooksV1(
+	ctx context.Context,
+	req *desc.ListWebhooksV1Request) (*desc.ListWebhooksV1Response, error) {
+	metrics.IncTotalRequestsCounter()
+	log.Debug().Msg("ListWebhooksV1() was called")
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "ListWebhooksV1")
+	defer span.Finish()
+
+	webhooks, err := a.webhookRepo.ListWebhooks(ctx)
+	if err != nil {
+		log.Error().Err(err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	responseWebhooks := make([]*desc.Webhook, 0, len(webhooks))
+	for _, webhook := range webhooks {
+		responseWebhooks = append(responseWebhooks, converter.WebhookToDTO(&webhook))
+	}
+
+	return &desc.ListWebhooksV1Response{Webhooks: responseWebhooks}, nil
+}
+
+// RemoveWebhookV1 is the method that handles removing webhook subscription by id.
+func (a *api) RemoveWebhookV1(
+	ctx context.Context,
+	req *desc.RemoveWebhookV1Request) (*desc.RemoveWebhookV1Response, error) {
+	metrics.IncTotalRequestsCounter()
+	if err := req.Validate(); err != nil {
+		metrics.IncInvalidRequestsCounter()
+		log.Error().Err(err).Msg("invalid argument")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	log.Debug().Msgf("RemoveWebhookV1() was called (id=%d)", req.Id)
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "RemoveWebhookV1")
+	defer span.Finish()
+
+	if err := a.webhookRepo.RemoveWebhook(ctx, req.Id); err != nil {
+		log.Error().Err(err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &desc.RemoveWebhookV1Response{}, nil
+}
+
+// ListWebhookDeliveriesV1 is the method that handles fetching the delivery log of the subscription.
+func (a *api) ListWebhookDeliveriesV1(
+	ctx context.Context,
+	req *desc.ListWebhookDeliveriesV1Request) (*desc.ListWebhookDeliveriesV1Response, error) {
+	metrics.IncTotalRequestsCounter()
+	if err := req.Validate(); err != nil {
+		metrics.IncInvalidRequestsCounter()
+		log.Error().Err(err).Msg("invalid argument")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	log.Debug().Msgf("ListWebhookDeliveriesV1() was called (webhook_id=%d, limit=%d)", req.WebhookId, req.Limit)
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "ListWebhookDeliveriesV1")
+	defer span.Finish()
+
+	deliveries, err := a.webhookRepo.ListDeliveries(ctx, req.WebhookId, req.Limit)
+	if err != nil {
+		log.Error().Err(err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	responseDeliveries := make([]*desc.WebhookDelivery, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		responseDeliveries = append(responseDeliveries, converter.WebhookDeliveryToDTO(&delivery))
+	}
+
+	return &desc.ListWebhookDeliveriesV1Response{Deliveries: responseDeliveries}, nil
+}
@@ -0,0 +1,110 @@
+package api_test
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/ozoncp/ocp-team-api/internal/api"
+	"github.com/ozoncp/ocp-team-api/internal/mocks"
+	"github.com/ozoncp/ocp-team-api/internal/models"
+	"github.com/ozoncp/ocp-team-api/internal/operation"
+	desc "github.com/ozoncp/ocp-team-api/pkg/ocp-team-api"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("Webhook api", func() {
+
+	var (
+		ctrl *gomock.Controller
+
+		s                 desc.OcpTeamApiServer
+		mockRepo          *mocks.MockRepo
+		mockWebhookRepo   *mocks.MockWebhookRepo
+		mockKafkaProducer *mocks.MockProducer
+		mockSaver         *mocks.MockSaver
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockRepo = mocks.NewMockRepo(ctrl)
+		mockWebhookRepo = mocks.NewMockWebhookRepo(ctrl)
+		mockKafkaProducer = mocks.NewMockProducer(ctrl)
+		mockSaver = mocks.NewMockSaver(ctrl)
+		s = api.NewOcpTeamApi(mockRepo, mockWebhookRepo, mocks.NewMockDeadLetterRepo(ctrl), mockKafkaProducer, mockSaver, mocks.NewMockReplayer(ctrl), operation.NewRegistry(0, 0))
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("CreateWebhookV1()", func() {
+		It("registers subscription", func() {
+			mockWebhookRepo.EXPECT().CreateWebhook(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, webhook *models.Webhook) error {
+					webhook.Id = 7
+					return nil
+				})
+
+			req := &desc.CreateWebhookV1Request{
+				Url:    "https://example.com/hook",
+				Events: []string{"Create", "Delete"},
+				Secret: "0123456789abcdef",
+			}
+
+			resp, err := s.CreateWebhookV1(context.Background(), req)
+			Expect(err).Should(BeNil())
+			Expect(resp.Id).Should(Equal(uint64(7)))
+		})
+
+		It("rejects URL which is not http or https", func() {
+			mockWebhookRepo.EXPECT().CreateWebhook(gomock.Any(), gomock.Any()).Times(0)
+
+			for _, url := range []string{"file:///etc/passwd", "gopher://example.com/hook", "example.com/hook"} {
+				req := &desc.CreateWebhookV1Request{Url: url, Secret: "0123456789abcdef"}
+
+				_, err := s.CreateWebhookV1(context.Background(), req)
+				Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+			}
+		})
+
+		It("rejects unknown event in filter", func() {
+			mockWebhookRepo.EXPECT().CreateWebhook(gomock.Any(), gomock.Any()).Times(0)
+
+			req := &desc.CreateWebhookV1Request{
+				Url:    "https://example.com/hook",
+				Events: []string{"Renamed"},
+				Secret: "0123456789abcdef",
+			}
+
+			_, err := s.CreateWebhookV1(context.Background(), req)
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+	})
+
+	Context("ListWebhooksV1()", func() {
+		It("does not expose secrets", func() {
+			mockWebhookRepo.EXPECT().ListWebhooks(gomock.Any()).Return([]models.Webhook{
+				{Id: 1, URL: "https://example.com/hook", Secret: "0123456789abcdef", IsEnabled: true},
+			}, nil)
+
+			resp, err := s.ListWebhooksV1(context.Background(), &desc.ListWebhooksV1Request{})
+			Expect(err).Should(BeNil())
+			Expect(resp.Webhooks).Should(Equal([]*desc.Webhook{
+				{Id: 1, Url: "https://example.com/hook", Enabled: true},
+			}))
+		})
+	})
+
+	Context("RemoveWebhookV1()", func() {
+		It("returns internal error when repo fails", func() {
+			mockWebhookRepo.EXPECT().RemoveWebhook(gomock.Any(), uint64(1)).Return(errors.New("error"))
+
+			_, err := s.RemoveWebhookV1(context.Background(), &desc.RemoveWebhookV1Request{Id: 1})
+			Expect(status.Code(err)).Should(Equal(codes.Internal))
+		})
+	})
+})
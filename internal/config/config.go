@@ -12,14 +12,19 @@ var fileCfg = "config.yml"
 
 // Config is the struct that represents application configuration.
 type Config struct {
-	Project  *Project  `yaml:"project"`
-	Database *Database `yaml:"database"`
-	Server   *Server   `yaml:"server"`
-	Status   *Status   `yaml:"status"`
-	Jaeger   *Jaeger   `yaml:"jaeger"`
-	Metrics  *Metrics  `yaml:"metrics"`
-	Kafka    *Kafka    `yaml:"kafka"`
-	Common   *Common   `yaml:"common"`
+	Project   *Project   `yaml:"project"`
+	Database  *Database  `yaml:"database"`
+	Cache     *Cache     `yaml:"cache"`
+	Server    *Server    `yaml:"server"`
+	Status    *Status    `yaml:"status"`
+	Jaeger    *Jaeger    `yaml:"jaeger"`
+	Metrics   *Metrics   `yaml:"metrics"`
+	Kafka     *Kafka     `yaml:"kafka"`
+	Webhook   *Webhook   `yaml:"webhook"`
+	Saver     *Saver     `yaml:"saver"`
+	Flusher   *Flusher   `yaml:"flusher"`
+	Operation *Operation `yaml:"operation"`
+	Common    *Common    `yaml:"common"`
 }
 
 var cfgInitOnce sync.Once
@@ -56,8 +61,39 @@ type Project struct {
 }
 
 // Database is the struct representing database settings in configuration.
+// Driver is "postgres" (default), "sqlite" or "memory". DSN is the file name for sqlite,
+// the rest of settings are used by postgres.
+// Read methods are routed to Replicas when they are set. HealthCheckInterval and
+// ReadYourWrites are in milliseconds, zero ReadYourWrites disables session pinning.
+// Repo calls longer than SlowQueryThreshold milliseconds are logged, zero disables the log.
+// The server refuses to start when the schema is older than the code requires, AutoMigrate
+// applies pending migrations on start first. The sqlite database is always migrated on start.
+// Pool settings apply to the primary and the replicas, ConnMaxLifetime and ConnMaxIdleTime
+// are in milliseconds, zero values keep the defaults of database/sql.
 type Database struct {
-	DSN string `yaml:"dsn"`
+	Driver              string   `yaml:"driver"`
+	DSN                 string   `yaml:"dsn"`
+	Replicas            []string `yaml:"replicas"`
+	HealthCheckInterval uint64   `yaml:"health_check_interval"`
+	ReadYourWrites      uint64   `yaml:"read_your_writes"`
+	SlowQueryThreshold  uint64   `yaml:"slow_query_threshold"`
+	AutoMigrate         bool     `yaml:"auto_migrate"`
+	MaxOpenConns        int      `yaml:"max_open_conns"`
+	MaxIdleConns        int      `yaml:"max_idle_conns"`
+	ConnMaxLifetime     uint64   `yaml:"conn_max_lifetime"`
+	ConnMaxIdleTime     uint64   `yaml:"conn_max_idle_time"`
+}
+
+// Cache is the struct representing settings of the cache of team lookups.
+// TTL is in milliseconds. Cached entries are dropped on writes of the instance
+// and, when KafkaInvalidation is set, on team events of the other instances.
+// PrimaryFill reads misses from the primary instead of the replicas.
+type Cache struct {
+	Enabled           bool   `yaml:"enabled"`
+	Size              int    `yaml:"size"`
+	TTL               uint64 `yaml:"ttl"`
+	KafkaInvalidation bool   `yaml:"kafka_invalidation"`
+	PrimaryFill       bool   `yaml:"primary_fill"`
 }
 
 // Server is the struct representing main server settings in configuration.
@@ -65,15 +101,19 @@ type Server struct {
 	Host         string `yaml:"host"`
 	HttpPort     string `yaml:"http_port"`
 	GrpcPort     string `yaml:"grpc_port"`
-	StartupTime  uint64 `yaml:"startup_time"`
 	ShutdownTime uint64 `yaml:"shutdown_time"`
 }
 
 // Status is the struct representing status server settings in configuration.
+// The instance is ready when the last checks of the dependencies passed, they are run
+// every CheckInterval milliseconds, each one limited by CheckTimeout milliseconds.
 type Status struct {
 	Port          string `yaml:"port"`
 	HealthHandler string `yaml:"health_handler"`
 	ReadyHandler  string `yaml:"ready_handler"`
+	StatusHandler string `yaml:"status_handler"`
+	CheckInterval uint64 `yaml:"check_interval"`
+	CheckTimeout  uint64 `yaml:"check_timeout"`
 }
 
 // Jaeger is the struct representing jaeger settings in configuration.
@@ -89,11 +129,116 @@ type Metrics struct {
 
 // Kafka is the struct representing kafka settings in configuration.
 type Kafka struct {
-	Topic   string   `yaml:"topic"`
-	Brokers []string `yaml:"brokers"`
+	Topic       string       `yaml:"topic"`
+	Brokers     []string     `yaml:"brokers"`
+	CloudEvents *CloudEvents `yaml:"cloud_events"`
+	ClientId    string       `yaml:"client_id"`
+	Compression string       `yaml:"compression"`
+	TLS         *KafkaTLS    `yaml:"tls"`
+	SASL        *KafkaSASL   `yaml:"sasl"`
+}
+
+// KafkaTLS is the struct representing TLS settings of kafka connection.
+type KafkaTLS struct {
+	Enabled            bool   `yaml:"enabled"`
+	CAFile             string `yaml:"ca_file"`
+	CertFile           string `yaml:"cert_file"`
+	KeyFile            string `yaml:"key_file"`
+	InsecureSkipVerify bool   `yaml:"insecure_skip_verify"`
+}
+
+// KafkaSASL is the struct representing SASL authentication settings of kafka connection.
+// Mechanism is one of PLAIN, SCRAM-SHA-256 or SCRAM-SHA-512.
+type KafkaSASL struct {
+	Enabled   bool   `yaml:"enabled"`
+	Mechanism string `yaml:"mechanism"`
+	User      string `yaml:"user"`
+	Password  string `yaml:"password"`
+}
+
+// CloudEvents is the struct representing CloudEvents envelope settings of kafka messages.
+// Mode is either "structured" (default) or "binary".
+type CloudEvents struct {
+	Enabled bool   `yaml:"enabled"`
+	Mode    string `yaml:"mode"`
+	Source  string `yaml:"source"`
+}
+
+// Webhook is the struct representing webhook delivery settings in configuration.
+// Timeout is in seconds, backoffs are in milliseconds. Zero DisableAfter never disables subscriptions.
+type Webhook struct {
+	Workers        int    `yaml:"workers"`
+	QueueSize      int    `yaml:"queue_size"`
+	Timeout        uint64 `yaml:"timeout"`
+	MaxAttempts    uint32 `yaml:"max_attempts"`
+	InitialBackoff uint64 `yaml:"initial_backoff"`
+	MaxBackoff     uint64 `yaml:"max_backoff"`
+	DisableAfter   uint32 `yaml:"disable_after"`
+}
+
+// Saver is the struct representing settings of the saver of asynchronously created teams.
+// Interval and MaxAge are in milliseconds. OverflowPolicy is one of "block", "drop" or "error".
+// The buffer is flushed when any of capacity, interval, max_bytes or max_age is reached,
+// zero max_bytes and max_age are ignored. Teams failed to flush MaxFlushAttempts times
+// are moved to dead letters, zero MaxFlushAttempts retries them forever.
+type Saver struct {
+	Capacity         uint      `yaml:"capacity"`
+	Interval         uint64    `yaml:"interval"`
+	MaxBytes         int       `yaml:"max_bytes"`
+	MaxAge           uint64    `yaml:"max_age"`
+	QueueSize        uint      `yaml:"queue_size"`
+	OverflowPolicy   string    `yaml:"overflow_policy"`
+	MaxFlushAttempts int       `yaml:"max_flush_attempts"`
+	WAL              *SaverWAL `yaml:"wal"`
+}
+
+// SaverWAL is the struct representing write-ahead log settings of the saver.
+// SyncPolicy is one of "always", "interval" or "never", SyncInterval is in milliseconds.
+type SaverWAL struct {
+	Enabled      bool   `yaml:"enabled"`
+	Dir          string `yaml:"dir"`
+	SyncPolicy   string `yaml:"sync_policy"`
+	SyncInterval uint64 `yaml:"sync_interval"`
+	SegmentSize  int64  `yaml:"segment_size"`
+}
+
+// Flusher is the struct representing settings of the flusher used by the saver.
+// Backoffs are in milliseconds.
+type Flusher struct {
+	ChunkSize      int           `yaml:"chunk_size"`
+	Concurrency    int           `yaml:"concurrency"`
+	MaxAttempts    int           `yaml:"max_attempts"`
+	InitialBackoff uint64        `yaml:"initial_backoff"`
+	MaxBackoff     uint64        `yaml:"max_backoff"`
+	Sinks          *FlusherSinks `yaml:"sinks"`
+}
+
+// FlusherSinks is the struct representing sinks the flushed teams are written to after the database.
+type FlusherSinks struct {
+	Kafka   *FlusherSink `yaml:"kafka"`
+	Archive *FlusherSink `yaml:"archive"`
+}
+
+// FlusherSink is the struct representing settings of the single flusher sink.
+// Failure of required sink makes the teams flushed again, failure of
+// the other sinks is only logged. Path is used by the archive sink.
+type FlusherSink struct {
+	Enabled  bool   `yaml:"enabled"`
+	Required bool   `yaml:"required"`
+	Path     string `yaml:"path"`
+}
+
+// Operation is the struct representing settings of asynchronous operations tracking.
+// Retention and Timeout are in seconds, zero disables the limit.
+type Operation struct {
+	Retention uint64 `yaml:"retention"`
+	Timeout   uint64 `yaml:"timeout"`
 }
 
 // Common is the struct representing common settings in configuration.
+// Teams are created through COPY when there are at least BulkThreshold of them,
+// zero BulkThreshold disables COPY.
 type Common struct {
-	BatchSize int `yaml:"batch_size"`
+	BatchSize     int `yaml:"batch_size"`
+	BulkThreshold int `yaml:"bulk_threshold"`
 }
@@ -1,8 +1,15 @@
 package converter
 
 import (
+	"errors"
 	"github.com/ozoncp/ocp-team-api/internal/models"
+	"github.com/ozoncp/ocp-team-api/internal/operation"
+	"github.com/ozoncp/ocp-team-api/internal/repo"
 	desc "github.com/ozoncp/ocp-team-api/pkg/ocp-team-api"
+	"google.golang.org/genproto/googleapis/longrunning"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
 )
 
 // TeamToDTO is the method for converting
@@ -13,6 +20,7 @@ func TeamToDTO(team *models.Team) *desc.Team {
 		Id:          team.Id,
 		Name:        team.Name,
 		Description: team.Description,
+		ExternalId:  team.ExternalId,
 	}
 }
 
@@ -24,5 +32,142 @@ func TeamFromDTO(dto *desc.Team) *models.Team {
 		Id:          dto.Id,
 		Name:        dto.Name,
 		Description: dto.Description,
+		ExternalId:  dto.ExternalId,
+	}
+}
+
+// UpsertResultToDTO is the method for converting
+// outcome of upserting the team (repo.UpsertResult) into
+// protobuf-generated data transport object.
+func UpsertResultToDTO(result *repo.UpsertResult) *desc.UpsertTeamsV1Response_Result {
+	dto := &desc.UpsertTeamsV1Response_Result{
+		Id:         result.Id,
+		ExternalId: result.ExternalId,
+		Status:     desc.UpsertTeamsV1Response_UNCHANGED,
+	}
+
+	switch result.Status {
+	case repo.Created:
+		dto.Status = desc.UpsertTeamsV1Response_CREATED
+	case repo.Updated:
+		dto.Status = desc.UpsertTeamsV1Response_UPDATED
+	}
+
+	return dto
+}
+
+// WebhookToDTO is the method for converting
+// webhook subscription (models.Webhook) into
+// protobuf-generated data transport object.
+// The secret is never exposed.
+func WebhookToDTO(webhook *models.Webhook) *desc.Webhook {
+	return &desc.Webhook{
+		Id:       webhook.Id,
+		Url:      webhook.URL,
+		Events:   webhook.Events,
+		Enabled:  webhook.IsEnabled,
+		Failures: webhook.Failures,
+	}
+}
+
+// WebhookDeliveryToDTO is the method for converting
+// delivery log record (models.WebhookDelivery) into
+// protobuf-generated data transport object.
+func WebhookDeliveryToDTO(delivery *models.WebhookDelivery) *desc.WebhookDelivery {
+	return &desc.WebhookDelivery{
+		Id:          delivery.Id,
+		WebhookId:   delivery.WebhookId,
+		Event:       delivery.Event,
+		TeamId:      delivery.TeamId,
+		Success:     delivery.Success,
+		Attempts:    delivery.Attempts,
+		StatusCode:  int32(delivery.StatusCode),
+		Error:       delivery.Error,
+		DeliveredAt: delivery.DeliveredAt.Unix(),
+	}
+}
+
+// OperationToDTO is the method for converting
+// asynchronous operation (operation.Operation) into
+// protobuf-generated google.longrunning.Operation.
+func OperationToDTO(op *operation.Operation) (*longrunning.Operation, error) {
+	metadata, err := anypb.New(OperationMetadataToDTO(op))
+	if err != nil {
+		return nil, err
+	}
+
+	dto := &longrunning.Operation{
+		Name:     op.Name(),
+		Metadata: metadata,
+		Done:     op.Done(),
+	}
+
+	ids, err := anypb.New(&desc.MultiCreateTeamV1Response{Ids: op.Ids})
+	if err != nil {
+		return nil, err
+	}
+
+	switch op.State {
+	case operation.Done:
+		dto.Result = &longrunning.Operation_Response{Response: ids}
+	case operation.Failed:
+		opErr := op.Err
+		if opErr == nil {
+			opErr = errors.New("operation failed")
+		}
+		st := status.New(operationErrorCode(opErr), opErr.Error()).Proto()
+		st.Details = append(st.Details, ids)
+		dto.Result = &longrunning.Operation_Error{Error: st}
+	}
+
+	return dto, nil
+}
+
+// OperationMetadataToDTO is the method for converting
+// progress of the asynchronous operation into
+// protobuf-generated data transport object.
+func OperationMetadataToDTO(op *operation.Operation) *desc.OperationMetadataV1 {
+	state := desc.OperationMetadataV1_PENDING
+	switch op.State {
+	case operation.Done:
+		state = desc.OperationMetadataV1_DONE
+	case operation.Failed:
+		state = desc.OperationMetadataV1_FAILED
+	}
+
+	return &desc.OperationMetadataV1{
+		State:      state,
+		Total:      uint32(op.Total),
+		Created:    uint32(op.Created),
+		Failed:     uint32(op.Failed),
+		CreateTime: op.CreatedAt.Unix(),
+		UpdateTime: op.UpdatedAt.Unix(),
+	}
+}
+
+func operationErrorCode(err error) codes.Code {
+	if errors.Is(err, operation.ErrTimeout) {
+		return codes.DeadlineExceeded
+	}
+
+	if st, ok := status.FromError(err); ok {
+		return st.Code()
+	}
+
+	return codes.Internal
+}
+
+// DeadLetterToDTO is the method for converting
+// the team that could not be persisted (models.DeadLetter) into
+// protobuf-generated data transport object.
+func DeadLetterToDTO(letter *models.DeadLetter) *desc.DeadLetter {
+	return &desc.DeadLetter{
+		Id:         letter.Id,
+		Team:       TeamToDTO(&letter.Team),
+		Error:      letter.Error,
+		Attempts:   letter.Attempts,
+		CreateTime: letter.CreatedAt.Unix(),
+		UpdateTime: letter.UpdatedAt.Unix(),
+		Sinks:      letter.Sinks,
 	}
 }
@@ -0,0 +1,53 @@
+package flusher
+
+import (
+	"context"
+	"errors"
+	"github.com/ozoncp/ocp-team-api/internal/metrics"
+	"github.com/ozoncp/ocp-team-api/internal/models"
+	"github.com/ozoncp/ocp-team-api/internal/repo"
+	"github.com/rs/zerolog/log"
+)
+
+// deadLetterHandler is the RejectHandler that stores rejected teams to the dead-letter store.
+type deadLetterHandler struct {
+	deadLetterRepo repo.DeadLetterRepo
+}
+
+// attemptsCounter is the interface of errors telling how many times the team failed.
+type attemptsCounter interface {
+	Attempts() int
+}
+
+// NewDeadLetterHandler is the constructor method for the reject handler that stores
+// rejected teams with their error to repo.DeadLetterRepo. The number of attempts is
+// taken from the error implementing Attempts() int, it is 1 otherwise. The sinks of
+// created teams are taken from *SinkError. Teams that cannot be stored are logged.
+func NewDeadLetterHandler(deadLetterRepo repo.DeadLetterRepo) *deadLetterHandler {
+	return &deadLetterHandler{deadLetterRepo: deadLetterRepo}
+}
+
+// Reject is the method that adds the team to the dead-letter store and refreshes its size metric.
+func (h *deadLetterHandler) Reject(ctx context.Context, team models.Team, err error) {
+	letter := models.DeadLetter{Team: team, Error: err.Error(), Attempts: 1}
+
+	var counter attemptsCounter
+	if errors.As(err, &counter) && counter.Attempts() > 0 {
+		letter.Attempts = uint32(counter.Attempts())
+	}
+
+	var sinkErr *SinkError
+	if errors.As(err, &sinkErr) {
+		letter.Sinks = sinkErr.Sinks
+	}
+
+	if addErr := h.deadLetterRepo.AddDeadLetter(ctx, &letter); addErr != nil {
+		log.Error().Err(addErr).Msgf("cannot add team %s rejected with %v to dead letters", team, err)
+		return
+	}
+	log.Warn().Err(err).Msgf("team %s is moved to dead letters with id=%d", team, letter.Id)
+
+	if size, countErr := h.deadLetterRepo.CountDeadLetters(ctx); countErr == nil {
+		metrics.SetDeadLetterSize(size)
+	}
+}
@@ -0,0 +1,94 @@
+package flusher_test
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/gomega"
+	"github.com/ozoncp/ocp-team-api/internal/flusher"
+	"github.com/ozoncp/ocp-team-api/internal/mocks"
+	"github.com/ozoncp/ocp-team-api/internal/models"
+)
+
+// attemptsError is the error of the team failed several times, like the one of the saver.
+type attemptsError struct {
+	attempts int
+}
+
+func (e attemptsError) Error() string {
+	return "invalid"
+}
+
+func (e attemptsError) Attempts() int {
+	return e.attempts
+}
+
+var _ = Describe("DeadLetterHandler", func() {
+
+	var (
+		ctrl               *gomock.Controller
+		mockDeadLetterRepo *mocks.MockDeadLetterRepo
+		ctx                context.Context
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockDeadLetterRepo = mocks.NewMockDeadLetterRepo(ctrl)
+		ctx = context.Background()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("stores rejected team with its error and attempts", func() {
+		team := models.Team{Name: "Name", Description: "Desc"}
+		err := attemptsError{attempts: 3}
+
+		mockDeadLetterRepo.EXPECT().AddDeadLetter(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, letter *models.DeadLetter) error {
+				gomega.Expect(letter.Team).Should(gomega.Equal(team))
+				gomega.Expect(letter.Error).Should(gomega.Equal("invalid"))
+				gomega.Expect(letter.Attempts).Should(gomega.Equal(uint32(3)))
+				letter.Id = 1
+				return nil
+			})
+		mockDeadLetterRepo.EXPECT().CountDeadLetters(gomock.Any()).Return(uint64(1), nil)
+
+		flusher.NewDeadLetterHandler(mockDeadLetterRepo).Reject(ctx, team, err)
+	})
+
+	It("stores created team with the sinks it is not written to", func() {
+		team := models.Team{Id: 7, Name: "Name", ExternalId: "ext-7"}
+		err := &flusher.SinkError{Sinks: []string{"kafka", "search"}, Err: errors.New("unavailable")}
+
+		mockDeadLetterRepo.EXPECT().AddDeadLetter(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, letter *models.DeadLetter) error {
+				gomega.Expect(letter.Team).Should(gomega.Equal(team))
+				gomega.Expect(letter.Sinks).Should(gomega.Equal([]string{"kafka", "search"}))
+				return nil
+			})
+		mockDeadLetterRepo.EXPECT().CountDeadLetters(gomock.Any()).Return(uint64(1), nil)
+
+		flusher.NewDeadLetterHandler(mockDeadLetterRepo).Reject(ctx, team, err)
+	})
+
+	It("counts the single attempt of teams rejected by the flusher", func() {
+		mockDeadLetterRepo.EXPECT().AddDeadLetter(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, letter *models.DeadLetter) error {
+				gomega.Expect(letter.Attempts).Should(gomega.Equal(uint32(1)))
+				return nil
+			})
+		mockDeadLetterRepo.EXPECT().CountDeadLetters(gomock.Any()).Return(uint64(1), nil)
+
+		flusher.NewDeadLetterHandler(mockDeadLetterRepo).Reject(ctx, models.Team{Name: "Name"}, errors.New("invalid"))
+	})
+
+	It("does not count dead letters when the team is not stored", func() {
+		mockDeadLetterRepo.EXPECT().AddDeadLetter(gomock.Any(), gomock.Any()).Return(errors.New("db is down"))
+		mockDeadLetterRepo.EXPECT().CountDeadLetters(gomock.Any()).Times(0)
+
+		flusher.NewDeadLetterHandler(mockDeadLetterRepo).Reject(ctx, models.Team{Name: "Name"}, errors.New("invalid"))
+	})
+})
@@ -0,0 +1,221 @@
+package flusher
+
+import (
+	"context"
+	"fmt"
+	"github.com/ozoncp/ocp-team-api/internal/models"
+	"github.com/ozoncp/ocp-team-api/internal/utils"
+	"github.com/rs/zerolog/log"
+	"strings"
+)
+
+// Sink is the interface of the destination the created teams are written to by the fan-out flusher.
+type Sink interface {
+	Write(ctx context.Context, teams []models.Team) error
+}
+
+// SinkFunc is the adapter allowing to use ordinary function as Sink.
+type SinkFunc func(ctx context.Context, teams []models.Team) error
+
+// Write is the method that calls f(ctx, teams).
+func (f SinkFunc) Write(ctx context.Context, teams []models.Team) error {
+	return f(ctx, teams)
+}
+
+// SinkPolicy is the behaviour of the fan-out flusher when the sink fails.
+type SinkPolicy uint8
+
+const (
+	// Required sink failure fails the chunk: the rest of the sinks are skipped
+	// and the teams are reported as failed to be flushed again.
+	Required SinkPolicy = iota
+	// BestEffort sink failure is only logged and reported.
+	BestEffort
+)
+
+// String is the method for converting SinkPolicy to string representation.
+func (p SinkPolicy) String() string {
+	if p == BestEffort {
+		return "best-effort"
+	}
+	return "required"
+}
+
+// SinkConfig is the struct binding the sink to its name and failure policy.
+type SinkConfig struct {
+	Name   string
+	Sink   Sink
+	Policy SinkPolicy
+}
+
+// SinkResult is the struct representing outcome of writing to the single sink.
+// Written and Failed count teams, Skipped counts teams of chunks
+// not written since one of the previous required sinks failed.
+// Err is the first error of the sink.
+type SinkResult struct {
+	Name    string
+	Policy  SinkPolicy
+	Written int
+	Failed  int
+	Skipped int
+	Err     error
+}
+
+// SinkError is the error of created teams that are not written to some of the sinks.
+// Sinks are the names of the failed sinks and the sinks skipped after them, Err is
+// the error of the sink that failed the teams.
+type SinkError struct {
+	Sinks []string
+	Err   error
+}
+
+// Error is the method for converting SinkError to string representation.
+func (e *SinkError) Error() string {
+	return fmt.Sprintf("teams are not written to sinks %s: %v", strings.Join(e.Sinks, ", "), e.Err)
+}
+
+// Unwrap is the method returning the error of the first failed sink.
+func (e *SinkError) Unwrap() error {
+	return e.Err
+}
+
+// Replayer is the interface for writing created teams to the sinks again.
+type Replayer interface {
+	Replay(ctx context.Context, teams []models.Team, sinks []string) error
+}
+
+// fanOut is the struct that implements Flusher and Replayer interfaces writing to several sinks.
+type fanOut struct {
+	primary   Flusher
+	chunkSize int
+	sinks     []SinkConfig
+}
+
+// NewFanOut is the constructor method for the flusher that creates teams with the
+// primary flusher, which assigns ids, and then writes created teams chunk by chunk
+// to the sinks in the given order. Non-positive chunkSize writes all teams at once.
+// Teams that already have id are considered created by the previous flush whose
+// required sink failed: they skip the primary flusher, so the sinks get them
+// at least once.
+func NewFanOut(primary Flusher, chunkSize int, sinks ...SinkConfig) *fanOut {
+	return &fanOut{
+		primary:   primary,
+		chunkSize: chunkSize,
+		sinks:     sinks,
+	}
+}
+
+// Flush is the method that creates teams and passes them to the sinks.
+// Per-sink outcomes are reported in Result.Sinks.
+func (f *fanOut) Flush(ctx context.Context, teams []models.Team) Result {
+	created := make([]models.Team, 0, len(teams))
+	pending := make([]models.Team, 0, len(teams))
+
+	for _, team := range teams {
+		if team.Id != 0 {
+			created = append(created, team)
+		} else {
+			pending = append(pending, team)
+		}
+	}
+
+	result := Result{Created: created, Failed: make([]models.Team, 0)}
+	if len(pending) > 0 {
+		primary := f.primary.Flush(ctx, pending)
+		result.Created = append(result.Created, primary.Created...)
+		result.Failed = append(result.Failed, primary.Failed...)
+		result.Rejected = primary.Rejected
+		result.Chunks = primary.Chunks
+	}
+
+	result.Sinks = make([]SinkResult, len(f.sinks))
+	for i, sink := range f.sinks {
+		result.Sinks[i] = SinkResult{Name: sink.Name, Policy: sink.Policy}
+	}
+
+	chunkSize := f.chunkSize
+	if chunkSize <= 0 {
+		chunkSize = len(result.Created)
+	}
+
+	written := make([]models.Team, 0, len(result.Created))
+	for _, chunk := range utils.SplitToBulks(result.Created, chunkSize) {
+		sinkErr := f.writeChunk(ctx, chunk, result.Sinks)
+		if sinkErr == nil {
+			written = append(written, chunk...)
+			continue
+		}
+
+		result.Failed = append(result.Failed, chunk...)
+		for _, team := range chunk {
+			result.Unwritten = append(result.Unwritten, ItemError{Team: team, Err: sinkErr})
+		}
+	}
+	result.Created = written
+
+	return result
+}
+
+// Replay is the method that writes created teams to the named sinks in the order
+// of the sinks regardless of their policy. Empty sinks means all the sinks,
+// unknown names are ignored. It returns *SinkError naming the sinks failed.
+func (f *fanOut) Replay(ctx context.Context, teams []models.Team, sinks []string) error {
+	named := make(map[string]bool, len(sinks))
+	for _, name := range sinks {
+		named[name] = true
+	}
+
+	var sinkErr *SinkError
+	for _, sink := range f.sinks {
+		if len(sinks) > 0 && !named[sink.Name] {
+			continue
+		}
+
+		err := sink.Sink.Write(ctx, teams)
+		if err == nil {
+			continue
+		}
+
+		log.Error().Err(err).Msgf("cannot replay %d teams to sink %s", len(teams), sink.Name)
+		if sinkErr == nil {
+			sinkErr = &SinkError{Err: err}
+		}
+		sinkErr.Sinks = append(sinkErr.Sinks, sink.Name)
+	}
+
+	if sinkErr == nil {
+		return nil
+	}
+	return sinkErr
+}
+
+// writeChunk is the method that writes the chunk to the sinks in order.
+// It returns *SinkError if a required sink failed, it names the sinks
+// the chunk is not written to, best-effort ones included.
+func (f *fanOut) writeChunk(ctx context.Context, chunk []models.Team, results []SinkResult) *SinkError {
+	var failed []string
+	for i, sink := range f.sinks {
+		err := sink.Sink.Write(ctx, chunk)
+		if err == nil {
+			results[i].Written += len(chunk)
+			continue
+		}
+
+		results[i].Failed += len(chunk)
+		if results[i].Err == nil {
+			results[i].Err = err
+		}
+		log.Error().Err(err).Msgf("cannot write %d teams to %s sink %s", len(chunk), sink.Policy, sink.Name)
+		failed = append(failed, sink.Name)
+
+		if sink.Policy == Required {
+			for j := i + 1; j < len(f.sinks); j++ {
+				results[j].Skipped += len(chunk)
+				failed = append(failed, f.sinks[j].Name)
+			}
+			return &SinkError{Sinks: failed, Err: err}
+		}
+	}
+
+	return nil
+}
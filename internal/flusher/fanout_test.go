@@ -0,0 +1,211 @@
+package flusher_test
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/gomega"
+	"github.com/ozoncp/ocp-team-api/internal/flusher"
+	"github.com/ozoncp/ocp-team-api/internal/kafka"
+	"github.com/ozoncp/ocp-team-api/internal/mocks"
+	"github.com/ozoncp/ocp-team-api/internal/models"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+var _ = Describe("FanOut", func() {
+
+	var (
+		ctrl        *gomock.Controller
+		mockFlusher *mocks.MockFlusher
+		ctx         context.Context
+	)
+
+	teams := []models.Team{
+		{Name: "Team1", Description: "Desc1"},
+		{Name: "Team2", Description: "Desc2"},
+		{Name: "Team3", Description: "Desc3"},
+	}
+	created := []models.Team{
+		{Id: 1, Name: "Team1", Description: "Desc1"},
+		{Id: 2, Name: "Team2", Description: "Desc2"},
+		{Id: 3, Name: "Team3", Description: "Desc3"},
+	}
+
+	// recordingSink collects the chunks written to it and fails when fail returns true.
+	recordingSink := func(chunks *[][]models.Team, fail func(chunk []models.Team) bool) flusher.Sink {
+		return flusher.SinkFunc(func(_ context.Context, chunk []models.Team) error {
+			*chunks = append(*chunks, chunk)
+			if fail != nil && fail(chunk) {
+				return errors.New("sink error")
+			}
+			return nil
+		})
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockFlusher = mocks.NewMockFlusher(ctrl)
+		ctx = context.Background()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("writes created teams to the sinks chunk by chunk in order", func() {
+		mockFlusher.EXPECT().Flush(gomock.Any(), teams).Return(flusher.Result{Created: created})
+
+		var order []string
+		var first, second [][]models.Team
+		sink := func(name string, chunks *[][]models.Team) flusher.Sink {
+			inner := recordingSink(chunks, nil)
+			return flusher.SinkFunc(func(ctx context.Context, chunk []models.Team) error {
+				order = append(order, name)
+				return inner.Write(ctx, chunk)
+			})
+		}
+
+		result := flusher.NewFanOut(mockFlusher, 2,
+			flusher.SinkConfig{Name: "first", Sink: sink("first", &first)},
+			flusher.SinkConfig{Name: "second", Sink: sink("second", &second), Policy: flusher.BestEffort},
+		).Flush(ctx, teams)
+
+		gomega.Expect(result.Created).Should(gomega.Equal(created))
+		gomega.Expect(result.Failed).Should(gomega.BeEmpty())
+		gomega.Expect(first).Should(gomega.Equal([][]models.Team{created[:2], created[2:]}))
+		gomega.Expect(second).Should(gomega.Equal(first))
+		gomega.Expect(order).Should(gomega.Equal([]string{"first", "second", "first", "second"}))
+		gomega.Expect(result.Sinks).Should(gomega.Equal([]flusher.SinkResult{
+			{Name: "first", Policy: flusher.Required, Written: 3},
+			{Name: "second", Policy: flusher.BestEffort, Written: 3},
+		}))
+	})
+
+	It("fails the chunk when the required sink fails", func() {
+		mockFlusher.EXPECT().Flush(gomock.Any(), teams).Return(flusher.Result{Created: created})
+
+		var required, skipped [][]models.Team
+		failFirst := func(chunk []models.Team) bool { return chunk[0].Id == 1 }
+
+		result := flusher.NewFanOut(mockFlusher, 2,
+			flusher.SinkConfig{Name: "required", Sink: recordingSink(&required, failFirst)},
+			flusher.SinkConfig{Name: "next", Sink: recordingSink(&skipped, nil)},
+		).Flush(ctx, teams)
+
+		gomega.Expect(result.Created).Should(gomega.Equal(created[2:]))
+		gomega.Expect(result.Failed).Should(gomega.Equal(created[:2]))
+		gomega.Expect(skipped).Should(gomega.Equal([][]models.Team{created[2:]}))
+		gomega.Expect(result.Sinks[0].Failed).Should(gomega.Equal(2))
+		gomega.Expect(result.Sinks[0].Err).ShouldNot(gomega.BeNil())
+		gomega.Expect(result.Sinks[1].Skipped).Should(gomega.Equal(2))
+
+		gomega.Expect(result.Unwritten).Should(gomega.HaveLen(2))
+		var sinkErr *flusher.SinkError
+		gomega.Expect(errors.As(result.Unwritten[0].Err, &sinkErr)).Should(gomega.BeTrue())
+		gomega.Expect(sinkErr.Sinks).Should(gomega.Equal([]string{"required", "next"}))
+	})
+
+	It("keeps the chunk when the best-effort sink fails", func() {
+		mockFlusher.EXPECT().Flush(gomock.Any(), teams).Return(flusher.Result{Created: created})
+
+		var chunks [][]models.Team
+		result := flusher.NewFanOut(mockFlusher, 0,
+			flusher.SinkConfig{
+				Name:   "best-effort",
+				Sink:   recordingSink(&chunks, func([]models.Team) bool { return true }),
+				Policy: flusher.BestEffort,
+			},
+		).Flush(ctx, teams)
+
+		gomega.Expect(result.Created).Should(gomega.Equal(created))
+		gomega.Expect(result.Failed).Should(gomega.BeEmpty())
+		gomega.Expect(chunks).Should(gomega.HaveLen(1))
+		gomega.Expect(result.Sinks[0].Failed).Should(gomega.Equal(3))
+	})
+
+	It("does not create teams with id again", func() {
+		mockFlusher.EXPECT().Flush(gomock.Any(), teams[2:]).Return(flusher.Result{Created: created[2:]})
+
+		var chunks [][]models.Team
+		result := flusher.NewFanOut(mockFlusher, 10,
+			flusher.SinkConfig{Name: "sink", Sink: recordingSink(&chunks, nil)},
+		).Flush(ctx, append(created[:2:2], teams[2]))
+
+		gomega.Expect(result.Created).Should(gomega.Equal(created))
+		gomega.Expect(chunks).Should(gomega.Equal([][]models.Team{created}))
+	})
+
+	Context("Replay()", func() {
+		It("writes teams to the named sinks only", func() {
+			var first, second, third [][]models.Team
+			err := flusher.NewFanOut(mockFlusher, 10,
+				flusher.SinkConfig{Name: "first", Sink: recordingSink(&first, nil)},
+				flusher.SinkConfig{Name: "second", Sink: recordingSink(&second, nil)},
+				flusher.SinkConfig{Name: "third", Sink: recordingSink(&third, nil), Policy: flusher.BestEffort},
+			).Replay(ctx, created[:1], []string{"third", "second", "unknown"})
+
+			gomega.Expect(err).Should(gomega.BeNil())
+			gomega.Expect(first).Should(gomega.BeEmpty())
+			gomega.Expect(second).Should(gomega.Equal([][]models.Team{created[:1]}))
+			gomega.Expect(third).Should(gomega.Equal([][]models.Team{created[:1]}))
+		})
+
+		It("writes teams to all the sinks when none is named", func() {
+			var first, second [][]models.Team
+			err := flusher.NewFanOut(mockFlusher, 10,
+				flusher.SinkConfig{Name: "first", Sink: recordingSink(&first, nil)},
+				flusher.SinkConfig{Name: "second", Sink: recordingSink(&second, nil)},
+			).Replay(ctx, created, nil)
+
+			gomega.Expect(err).Should(gomega.BeNil())
+			gomega.Expect(first).Should(gomega.HaveLen(1))
+			gomega.Expect(second).Should(gomega.HaveLen(1))
+		})
+
+		It("names the failed sinks and writes to the rest", func() {
+			var first, second [][]models.Team
+			fail := func([]models.Team) bool { return true }
+			err := flusher.NewFanOut(mockFlusher, 10,
+				flusher.SinkConfig{Name: "first", Sink: recordingSink(&first, fail)},
+				flusher.SinkConfig{Name: "second", Sink: recordingSink(&second, nil)},
+			).Replay(ctx, created, nil)
+
+			var sinkErr *flusher.SinkError
+			gomega.Expect(errors.As(err, &sinkErr)).Should(gomega.BeTrue())
+			gomega.Expect(sinkErr.Sinks).Should(gomega.Equal([]string{"first"}))
+			gomega.Expect(second).Should(gomega.HaveLen(1))
+		})
+	})
+
+	It("sends create events with the producer sink", func() {
+		mockProducer := mocks.NewMockProducer(ctrl)
+		mockProducer.EXPECT().Send(kafka.NewMessage(1, kafka.Create)).Return(nil)
+		mockProducer.EXPECT().Send(kafka.NewMessage(2, kafka.Create)).Return(errors.New("kafka error"))
+
+		err := flusher.NewProducerSink(mockProducer).Write(ctx, created)
+		gomega.Expect(err).Should(gomega.MatchError("kafka error"))
+	})
+
+	It("appends teams to the archive", func() {
+		dir, err := ioutil.TempDir("", "archive")
+		gomega.Expect(err).Should(gomega.BeNil())
+		defer os.RemoveAll(dir)
+
+		path := filepath.Join(dir, "teams.jsonl")
+		archive, err := flusher.NewArchiveSink(path)
+		gomega.Expect(err).Should(gomega.BeNil())
+
+		gomega.Expect(archive.Write(ctx, created[:1])).Should(gomega.Succeed())
+		gomega.Expect(archive.Write(ctx, created[1:2])).Should(gomega.Succeed())
+		gomega.Expect(archive.Close()).Should(gomega.Succeed())
+
+		content, err := ioutil.ReadFile(path)
+		gomega.Expect(err).Should(gomega.BeNil())
+		gomega.Expect(string(content)).Should(gomega.Equal(
+			`{"id":1,"name":"Team1","description":"Desc1"}` + "\n" +
+				`{"id":2,"name":"Team2","description":"Desc2"}` + "\n"))
+	})
+})
@@ -2,44 +2,312 @@ package flusher
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"github.com/ozoncp/ocp-team-api/internal/metrics"
 	"github.com/ozoncp/ocp-team-api/internal/models"
 	"github.com/ozoncp/ocp-team-api/internal/repo"
 	"github.com/ozoncp/ocp-team-api/internal/utils"
+	"github.com/rs/zerolog/log"
+	"sync"
+	"time"
 )
 
+// ErrIdsMismatch is the error of the chunk the repo returned wrong number of ids for.
+// Such chunk is neither retried nor bisected, as its teams may have been created.
+var ErrIdsMismatch = errors.New("number of created ids does not match number of teams")
+
 // Flusher is the interface for flushing teams into repo.
 type Flusher interface {
-	Flush(ctx context.Context, teams []models.Team) []models.Team
+	Flush(ctx context.Context, teams []models.Team) Result
+}
+
+// Result is the struct representing detailed outcome of the flush.
+// Created teams have their ids filled. Failed teams hit transient errors
+// and are worth flushing again later. Rejected teams are permanently
+// invalid and have been passed to the reject handler. Sinks and Unwritten
+// are filled by the fan-out flusher only: Unwritten holds the failed teams
+// which are created but not written to the sinks named by *SinkError.
+type Result struct {
+	Created   []models.Team
+	Failed    []models.Team
+	Rejected  []ItemError
+	Unwritten []ItemError
+	Chunks    []ChunkResult
+	Sinks     []SinkResult
+}
+
+// ChunkResult is the struct representing outcome of the single chunk.
+// Err is the last error of the chunk, it is nil when bisection has
+// created every team after all. Items holds per-item errors
+// of rows isolated by bisection.
+type ChunkResult struct {
+	Size     int
+	Attempts int
+	Err      error
+	Items    []ItemError
+}
+
+// ItemError is the struct binding the team to the error it caused.
+type ItemError struct {
+	Team models.Team
+	Err  error
+}
+
+// RejectHandler is the interface for handling teams that can never be persisted.
+type RejectHandler interface {
+	Reject(ctx context.Context, team models.Team, err error)
+}
+
+// RejectHandlerFunc is the adapter allowing to use ordinary function as RejectHandler.
+type RejectHandlerFunc func(ctx context.Context, team models.Team, err error)
+
+// Reject is the method that calls f(ctx, team, err).
+func (f RejectHandlerFunc) Reject(ctx context.Context, team models.Team, err error) {
+	f(ctx, team, err)
+}
+
+// logRejectHandler is the default RejectHandler that only logs rejected teams.
+var logRejectHandler = RejectHandlerFunc(func(_ context.Context, team models.Team, err error) {
+	log.Error().Err(err).Msgf("team %s is rejected", team)
+})
+
+// Option is the type of optional flusher settings passed to NewFlusher.
+type Option func(f *flusher)
+
+// WithRetry is the option enabling retries of chunks failed with transient errors.
+// The delay before n-th retry is initialBackoff*2^(n-1) limited by maxBackoff,
+// randomized by jitter. By default, every chunk is tried once.
+func WithRetry(maxAttempts int, initialBackoff, maxBackoff time.Duration) Option {
+	return func(f *flusher) {
+		f.maxAttempts = maxAttempts
+		f.initialBackoff = initialBackoff
+		f.maxBackoff = maxBackoff
+	}
+}
+
+// WithRejectHandler is the option setting the handler of permanently invalid teams.
+// By default, rejected teams are logged.
+func WithRejectHandler(handler RejectHandler) Option {
+	return func(f *flusher) {
+		f.rejectHandler = handler
+	}
+}
+
+// WithConcurrency is the option setting the number of chunks flushed in parallel.
+// The reject handler may be called concurrently when n is greater than 1.
+// By default, chunks are flushed one after another.
+func WithConcurrency(n int) Option {
+	return func(f *flusher) {
+		f.concurrency = n
+	}
+}
+
+// WithOrderedResults is the option keeping created, failed and rejected teams
+// as well as chunk results in the input order. By default, chunks appear
+// in the result in order of completion.
+func WithOrderedResults() Option {
+	return func(f *flusher) {
+		f.ordered = true
+	}
+}
+
+// WithBulkThreshold is the option creating chunks of at least n teams with
+// repo.Repo.BulkCreateTeams (COPY) instead of CreateTeams. It pays off with
+// the chunk size of thousands of teams. By default, bulk creation is not used.
+func WithBulkThreshold(n int) Option {
+	return func(f *flusher) {
+		f.bulkThreshold = n
+	}
 }
 
 // flusher is the struct that implements Flusher interface.
 type flusher struct {
-	chunkSize int
-	teamRepo  repo.Repo
+	chunkSize      int
+	teamRepo       repo.Repo
+	concurrency    int
+	ordered        bool
+	maxAttempts    int
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+	rejectHandler  RejectHandler
+	bulkThreshold  int
 }
 
 // NewFlusher is the constructor method for flusher struct.
 func NewFlusher(
 	chunkSize int,
 	teamRepo repo.Repo,
+	opts ...Option,
 ) *flusher {
-	return &flusher{
-		chunkSize: chunkSize,
-		teamRepo:  teamRepo,
+	f := &flusher{
+		chunkSize:     chunkSize,
+		teamRepo:      teamRepo,
+		concurrency:   1,
+		maxAttempts:   1,
+		rejectHandler: logRejectHandler,
 	}
+
+	for _, opt := range opts {
+		opt(f)
+	}
+
+	if f.concurrency < 1 {
+		f.concurrency = 1
+	}
+
+	if f.maxAttempts < 1 {
+		f.maxAttempts = 1
+	}
+
+	if f.maxBackoff < f.initialBackoff {
+		f.maxBackoff = f.initialBackoff
+	}
+
+	return f
 }
 
 // Flush is the method that creates new teams using repo.Repo batch-by-batch.
-func (f *flusher) Flush(ctx context.Context, teams []models.Team) []models.Team {
+// Up to concurrency chunks are flushed in parallel. Chunks failed with transient
+// errors are retried, chunks failed with permanent errors are bisected to isolate
+// the invalid teams which are rejected. Chunks not started before ctx is done
+// are reported as failed.
+func (f *flusher) Flush(ctx context.Context, teams []models.Team) Result {
 	batches := utils.SplitToBulks(teams, f.chunkSize)
 
-	failed := make([]models.Team, 0)
+	var (
+		partials  = make([]Result, len(batches))
+		completed = make(chan int, len(batches))
+		sem       = make(chan struct{}, f.concurrency)
+		wg        sync.WaitGroup
+	)
 
-	for _, chunk := range batches {
-		if _, err := f.teamRepo.CreateTeams(ctx, chunk); err != nil {
-			failed = append(failed, chunk...)
+	for i, chunk := range batches {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
 		}
+
+		if err := ctx.Err(); err != nil {
+			partials[i] = Result{
+				Failed: chunk,
+				Chunks: []ChunkResult{{Size: len(chunk), Err: err}},
+			}
+			completed <- i
+			continue
+		}
+
+		wg.Add(1)
+		go func(i int, chunk []models.Team) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			start := time.Now()
+			chunkResult := ChunkResult{Size: len(chunk)}
+			chunkResult.Err = f.flushChunk(ctx, chunk, &partials[i], &chunkResult)
+			partials[i].Chunks = []ChunkResult{chunkResult}
+			metrics.ObserveFlushChunkDuration(time.Since(start), chunkResult.Err == nil)
+
+			completed <- i
+		}(i, chunk)
+	}
+
+	wg.Wait()
+	close(completed)
+
+	result := Result{
+		Created: make([]models.Team, 0, len(teams)),
+		Failed:  make([]models.Team, 0),
+		Chunks:  make([]ChunkResult, 0, len(batches)),
+	}
+
+	merge := func(partial Result) {
+		result.Created = append(result.Created, partial.Created...)
+		result.Failed = append(result.Failed, partial.Failed...)
+		result.Rejected = append(result.Rejected, partial.Rejected...)
+		result.Chunks = append(result.Chunks, partial.Chunks...)
 	}
 
-	return failed
+	if f.ordered {
+		for _, partial := range partials {
+			merge(partial)
+		}
+	} else {
+		for i := range completed {
+			merge(partials[i])
+		}
+	}
+
+	metrics.AddFlusherItems(len(result.Created), len(result.Failed), len(result.Rejected))
+
+	return result
+}
+
+// flushChunk is the method that flushes the chunk with retries and,
+// when the chunk is permanently invalid, bisects it down to single teams.
+// It returns the last error of the chunk, which is nil when every team is created.
+func (f *flusher) flushChunk(ctx context.Context, chunk []models.Team, result *Result, chunkResult *ChunkResult) error {
+	ids, attempts, err := f.create(ctx, chunk)
+	chunkResult.Attempts += attempts
+
+	if err == nil {
+		for i, team := range chunk {
+			team.Id = ids[i]
+			result.Created = append(result.Created, team)
+		}
+		return nil
+	}
+
+	if repo.IsTransient(err) || ctx.Err() != nil || errors.Is(err, ErrIdsMismatch) {
+		result.Failed = append(result.Failed, chunk...)
+		return err
+	}
+
+	if len(chunk) == 1 {
+		item := ItemError{Team: chunk[0], Err: err}
+		chunkResult.Items = append(chunkResult.Items, item)
+		result.Rejected = append(result.Rejected, item)
+		f.rejectHandler.Reject(ctx, chunk[0], err)
+		return err
+	}
+
+	middle := len(chunk) / 2
+	errFirst := f.flushChunk(ctx, chunk[:middle], result, chunkResult)
+	if errLast := f.flushChunk(ctx, chunk[middle:], result, chunkResult); errLast != nil {
+		return errLast
+	}
+	return errFirst
+}
+
+// create is the method that calls repo.Repo retrying transient errors.
+// It returns the ids, number of attempts made and the last error.
+func (f *flusher) create(ctx context.Context, chunk []models.Team) ([]uint64, int, error) {
+	backoff := f.initialBackoff
+
+	for attempt := 1; ; attempt++ {
+		var (
+			ids []uint64
+			err error
+		)
+		if f.bulkThreshold > 0 && len(chunk) >= f.bulkThreshold {
+			ids, err = f.teamRepo.BulkCreateTeams(ctx, chunk)
+		} else {
+			ids, err = f.teamRepo.CreateTeams(ctx, chunk)
+		}
+		if err == nil && len(ids) != len(chunk) {
+			return nil, attempt, fmt.Errorf("%w: %d ids for %d teams", ErrIdsMismatch, len(ids), len(chunk))
+		}
+		if err == nil || !repo.IsTransient(err) || attempt >= f.maxAttempts {
+			return ids, attempt, err
+		}
+
+		if !utils.Sleep(ctx, utils.Jitter(backoff)) {
+			return nil, attempt, err
+		}
+		metrics.IncFlusherRetriesCounter()
+
+		if backoff *= 2; backoff > f.maxBackoff {
+			backoff = f.maxBackoff
+		}
+	}
 }
@@ -4,11 +4,13 @@ import (
 	"context"
 	"errors"
 	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgconn"
 	. "github.com/onsi/ginkgo"
 	"github.com/onsi/gomega"
 	"github.com/ozoncp/ocp-team-api/internal/flusher"
 	"github.com/ozoncp/ocp-team-api/internal/mocks"
 	"github.com/ozoncp/ocp-team-api/internal/models"
+	"time"
 )
 
 var _ = Describe("Flusher", func() {
@@ -22,13 +24,21 @@ var _ = Describe("Flusher", func() {
 
 	mockError := errors.New("error")
 
+	createTeams := func(_ context.Context, chunk []models.Team) ([]uint64, error) {
+		ids := make([]uint64, 0, len(chunk))
+		for _, team := range chunk {
+			ids = append(ids, team.Id)
+		}
+		return ids, nil
+	}
+
 	emptyTeams := make([]models.Team, 0)
 	nonEmptyTeams := []models.Team{
-		{1, "Team1", "Desc1", false},
-		{2, "Team2", "Desc2", false},
-		{3, "Team3", "Desc3", false},
-		{4, "Team4", "Desc4", false},
-		{5, "Team5", "Desc5", false},
+		{1, "Team1", "Desc1", "", false},
+		{2, "Team2", "Desc2", "", false},
+		{3, "Team3", "Desc3", "", false},
+		{4, "Team4", "Desc4", "", false},
+		{5, "Team5", "Desc5", "", false},
 	}
 
 	BeforeEach(func() {
@@ -57,7 +67,7 @@ var _ = Describe("Flusher", func() {
 		})
 
 		AfterEach(func() {
-			gomega.Expect(f.Flush(context.TODO(), teams)).Should(gomega.BeEmpty())
+			gomega.Expect(f.Flush(context.TODO(), teams).Failed).Should(gomega.BeEmpty())
 		})
 	})
 
@@ -74,7 +84,7 @@ var _ = Describe("Flusher", func() {
 			It("returns empty slice", func() {
 				mockRepo.EXPECT().CreateTeams(gomock.Any(), gomock.Any()).Return(nil, nil).Times(0)
 
-				gomega.Expect(f.Flush(context.TODO(), teams)).Should(gomega.BeEmpty())
+				gomega.Expect(f.Flush(context.TODO(), teams).Failed).Should(gomega.BeEmpty())
 			})
 		})
 
@@ -84,9 +94,9 @@ var _ = Describe("Flusher", func() {
 			})
 
 			It("returns empty slice", func() {
-				mockRepo.EXPECT().CreateTeams(gomock.Any(), gomock.Any()).Return(nil, nil).Times(3)
+				mockRepo.EXPECT().CreateTeams(gomock.Any(), gomock.Any()).DoAndReturn(createTeams).Times(3)
 
-				gomega.Expect(f.Flush(context.TODO(), teams)).Should(gomega.BeEmpty())
+				gomega.Expect(f.Flush(context.TODO(), teams).Failed).Should(gomega.BeEmpty())
 			})
 		})
 
@@ -98,22 +108,174 @@ var _ = Describe("Flusher", func() {
 			It("cannot flush all teams", func() {
 				mockRepo.EXPECT().CreateTeams(gomock.Any(), gomock.Any()).Return(nil, mockError).Times(3)
 
-				gomega.Expect(f.Flush(context.TODO(), teams)).Should(gomega.Equal(teams))
+				gomega.Expect(f.Flush(context.TODO(), teams).Failed).Should(gomega.Equal(teams))
 			})
 
 			It("cannot flush last 3 teams", func() {
-				mockRepo.EXPECT().CreateTeams(gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+				mockRepo.EXPECT().CreateTeams(gomock.Any(), gomock.Any()).DoAndReturn(createTeams).Times(1)
 				mockRepo.EXPECT().CreateTeams(gomock.Any(), gomock.Any()).Return(nil, mockError).Times(2)
 
-				gomega.Expect(f.Flush(context.TODO(), teams)).Should(gomega.Equal(teams[2:]))
+				gomega.Expect(f.Flush(context.TODO(), teams).Failed).Should(gomega.Equal(teams[2:]))
 			})
 
 			It("cannot flush first 2 teams", func() {
 				mockRepo.EXPECT().CreateTeams(gomock.Any(), gomock.Any()).Return(nil, mockError).Times(1)
-				mockRepo.EXPECT().CreateTeams(gomock.Any(), gomock.Any()).Return(nil, nil).Times(2)
+				mockRepo.EXPECT().CreateTeams(gomock.Any(), gomock.Any()).DoAndReturn(createTeams).Times(2)
 
-				gomega.Expect(f.Flush(context.TODO(), teams)).Should(gomega.Equal(teams[:2]))
+				gomega.Expect(f.Flush(context.TODO(), teams).Failed).Should(gomega.Equal(teams[:2]))
 			})
 		})
 	})
+
+	Context("retrying flusher", func() {
+		var rejected []models.Team
+
+		BeforeEach(func() {
+			rejected = nil
+			f = flusher.NewFlusher(
+				4,
+				mockRepo,
+				flusher.WithRetry(3, time.Millisecond, 2*time.Millisecond),
+				flusher.WithRejectHandler(flusher.RejectHandlerFunc(
+					func(_ context.Context, team models.Team, _ error) {
+						rejected = append(rejected, team)
+					})),
+			)
+		})
+
+		It("retries transient errors", func() {
+			gomock.InOrder(
+				mockRepo.EXPECT().CreateTeams(gomock.Any(), nonEmptyTeams[:2]).Return(nil, mockError),
+				mockRepo.EXPECT().CreateTeams(gomock.Any(), nonEmptyTeams[:2]).Return([]uint64{10, 11}, nil),
+			)
+
+			result := f.Flush(context.TODO(), nonEmptyTeams[:2])
+			gomega.Expect(result.Failed).Should(gomega.BeEmpty())
+			gomega.Expect(result.Created).Should(gomega.HaveLen(2))
+			gomega.Expect(result.Created[1].Id).Should(gomega.Equal(uint64(11)))
+			gomega.Expect(result.Chunks).Should(gomega.HaveLen(1))
+			gomega.Expect(result.Chunks[0].Attempts).Should(gomega.Equal(2))
+			gomega.Expect(result.Chunks[0].Err).Should(gomega.BeNil())
+		})
+
+		It("gives up after max attempts", func() {
+			mockRepo.EXPECT().CreateTeams(gomock.Any(), gomock.Any()).Return(nil, mockError).Times(3)
+
+			result := f.Flush(context.TODO(), nonEmptyTeams[:2])
+			gomega.Expect(result.Failed).Should(gomega.Equal(nonEmptyTeams[:2]))
+			gomega.Expect(result.Chunks[0].Err).Should(gomega.Equal(mockError))
+			gomega.Expect(rejected).Should(gomega.BeEmpty())
+		})
+
+		It("isolates invalid teams by bisection", func() {
+			pgErr := &pgconn.PgError{Code: "23505", Message: "duplicate key"}
+			mockRepo.EXPECT().CreateTeams(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, chunk []models.Team) ([]uint64, error) {
+					ids := make([]uint64, 0, len(chunk))
+					for _, team := range chunk {
+						if team.Id == 3 {
+							return nil, pgErr
+						}
+						ids = append(ids, team.Id*10)
+					}
+					return ids, nil
+				}).AnyTimes()
+
+			result := f.Flush(context.TODO(), nonEmptyTeams)
+			gomega.Expect(result.Failed).Should(gomega.BeEmpty())
+			gomega.Expect(result.Created).Should(gomega.HaveLen(4))
+			gomega.Expect(result.Rejected).Should(gomega.Equal([]flusher.ItemError{{Team: nonEmptyTeams[2], Err: pgErr}}))
+			gomega.Expect(rejected).Should(gomega.Equal([]models.Team{nonEmptyTeams[2]}))
+			gomega.Expect(result.Chunks[0].Items).Should(gomega.HaveLen(1))
+			gomega.Expect(result.Chunks[1].Err).Should(gomega.BeNil())
+		})
+
+		It("does not report error of chunk created by bisection", func() {
+			pgErr := &pgconn.PgError{Code: "23505", Message: "duplicate key"}
+			gomock.InOrder(
+				mockRepo.EXPECT().CreateTeams(gomock.Any(), nonEmptyTeams[:4]).Return(nil, pgErr),
+				mockRepo.EXPECT().CreateTeams(gomock.Any(), nonEmptyTeams[:2]).Return([]uint64{10, 20}, nil),
+				mockRepo.EXPECT().CreateTeams(gomock.Any(), nonEmptyTeams[2:4]).Return([]uint64{30, 40}, nil),
+			)
+
+			result := f.Flush(context.TODO(), nonEmptyTeams[:4])
+			gomega.Expect(result.Created).Should(gomega.HaveLen(4))
+			gomega.Expect(result.Chunks[0].Attempts).Should(gomega.Equal(3))
+			gomega.Expect(result.Chunks[0].Err).Should(gomega.BeNil())
+		})
+
+		It("fails chunk with wrong number of ids", func() {
+			mockRepo.EXPECT().CreateTeams(gomock.Any(), nonEmptyTeams[:2]).Return([]uint64{10}, nil).Times(1)
+
+			result := f.Flush(context.TODO(), nonEmptyTeams[:2])
+			gomega.Expect(result.Created).Should(gomega.BeEmpty())
+			gomega.Expect(result.Failed).Should(gomega.Equal(nonEmptyTeams[:2]))
+			gomega.Expect(errors.Is(result.Chunks[0].Err, flusher.ErrIdsMismatch)).Should(gomega.BeTrue())
+			gomega.Expect(rejected).Should(gomega.BeEmpty())
+		})
+	})
+
+	Context("parallel flusher", func() {
+		It("keeps input order when requested", func() {
+			mockRepo.EXPECT().CreateTeams(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, chunk []models.Team) ([]uint64, error) {
+					time.Sleep(time.Duration(5-chunk[0].Id) * time.Millisecond)
+					return []uint64{chunk[0].Id * 10}, nil
+				}).Times(5)
+
+			f = flusher.NewFlusher(1, mockRepo, flusher.WithConcurrency(3), flusher.WithOrderedResults())
+
+			result := f.Flush(context.TODO(), nonEmptyTeams)
+			ids := make([]uint64, 0, len(result.Created))
+			for _, team := range result.Created {
+				ids = append(ids, team.Id)
+			}
+			gomega.Expect(ids).Should(gomega.Equal([]uint64{10, 20, 30, 40, 50}))
+			gomega.Expect(result.Chunks).Should(gomega.HaveLen(5))
+		})
+
+		It("does not start chunks after context is done", func() {
+			mockRepo.EXPECT().CreateTeams(gomock.Any(), gomock.Any()).Times(0)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			f = flusher.NewFlusher(2, mockRepo, flusher.WithConcurrency(2))
+
+			result := f.Flush(ctx, nonEmptyTeams)
+			gomega.Expect(result.Failed).Should(gomega.ConsistOf(nonEmptyTeams))
+			gomega.Expect(result.Chunks).Should(gomega.HaveLen(3))
+			gomega.Expect(result.Chunks[0].Err).Should(gomega.Equal(context.Canceled))
+		})
+	})
+
+	Context("bulk flusher", func() {
+		It("creates chunks above threshold through bulk creation", func() {
+			gomock.InOrder(
+				mockRepo.EXPECT().BulkCreateTeams(gomock.Any(), nonEmptyTeams[:3]).Return([]uint64{10, 20, 30}, nil),
+				mockRepo.EXPECT().CreateTeams(gomock.Any(), nonEmptyTeams[3:]).Return([]uint64{40, 50}, nil),
+			)
+
+			f = flusher.NewFlusher(3, mockRepo, flusher.WithBulkThreshold(3))
+
+			result := f.Flush(context.TODO(), nonEmptyTeams)
+			gomega.Expect(result.Failed).Should(gomega.BeEmpty())
+			gomega.Expect(result.Created).Should(gomega.HaveLen(5))
+			gomega.Expect(result.Created[2].Id).Should(gomega.Equal(uint64(30)))
+		})
+
+		It("falls back to batches when bisecting invalid chunk", func() {
+			pgErr := &pgconn.PgError{Code: "22001", Message: "value too long"}
+			mockRepo.EXPECT().BulkCreateTeams(gomock.Any(), nonEmptyTeams[:4]).Return(nil, pgErr)
+			mockRepo.EXPECT().CreateTeams(gomock.Any(), nonEmptyTeams[:2]).Return([]uint64{10, 20}, nil)
+			mockRepo.EXPECT().CreateTeams(gomock.Any(), nonEmptyTeams[2:4]).Return([]uint64{30, 40}, nil)
+			mockRepo.EXPECT().CreateTeams(gomock.Any(), nonEmptyTeams[4:]).Return([]uint64{50}, nil)
+
+			f = flusher.NewFlusher(4, mockRepo, flusher.WithBulkThreshold(3), flusher.WithOrderedResults())
+
+			result := f.Flush(context.TODO(), nonEmptyTeams)
+			gomega.Expect(result.Created).Should(gomega.HaveLen(5))
+			gomega.Expect(result.Rejected).Should(gomega.BeEmpty())
+		})
+	})
 })
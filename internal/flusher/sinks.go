@@ -0,0 +1,80 @@
+package flusher
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/ozoncp/ocp-team-api/internal/kafka"
+	"github.com/ozoncp/ocp-team-api/internal/models"
+	"os"
+	"sync"
+)
+
+// NewProducerSink is the constructor method for the sink sending Create event
+// of every team to kafka. It stops at the first failed message.
+func NewProducerSink(producer kafka.Producer) Sink {
+	return SinkFunc(func(ctx context.Context, teams []models.Team) error {
+		for _, team := range teams {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			if err := producer.Send(kafka.NewMessage(team.Id, kafka.Create)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// archiveRecord is the line of the archive file.
+type archiveRecord struct {
+	Id          uint64 `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// archiveSink is the sink appending teams to the file as JSON lines.
+type archiveSink struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+// NewArchiveSink is the constructor method for the sink appending teams
+// to the file at path as JSON lines. Every write is synced to disk.
+func NewArchiveSink(path string) (*archiveSink, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, err
+	}
+
+	return &archiveSink{file: file}, nil
+}
+
+// Write is the method that appends teams to the archive.
+func (s *archiveSink) Write(_ context.Context, teams []models.Team) error {
+	var buf []byte
+	for _, team := range teams {
+		line, err := json.Marshal(archiveRecord{Id: team.Id, Name: team.Name, Description: team.Description})
+		if err != nil {
+			return err
+		}
+		buf = append(buf, line...)
+		buf = append(buf, '\n')
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := s.file.Write(buf); err != nil {
+		return err
+	}
+
+	return s.file.Sync()
+}
+
+// Close is the method for closing the archive file.
+func (s *archiveSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.file.Close()
+}
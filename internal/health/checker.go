@@ -0,0 +1,179 @@
+package health
+
+import (
+	"context"
+	"github.com/rs/zerolog/log"
+	"sync"
+	"time"
+)
+
+const (
+	defaultInterval = 5 * time.Second
+	defaultTimeout  = time.Second
+)
+
+// Check is the struct describing the dependency checked by Checker.
+// Failure of the optional dependency is reported but does not affect readiness.
+type Check struct {
+	Name     string
+	Check    func(ctx context.Context) error
+	Optional bool
+}
+
+// State is the struct representing the last result of the dependency check.
+type State struct {
+	Name      string    `json:"name"`
+	Healthy   bool      `json:"healthy"`
+	Optional  bool      `json:"optional,omitempty"`
+	Error     string    `json:"error,omitempty"`
+	LatencyMs float64   `json:"latency_ms"`
+	CheckedAt time.Time `json:"checked_at"`
+}
+
+// Status is the struct representing the state of all dependencies.
+type Status struct {
+	Ready        bool    `json:"ready"`
+	Dependencies []State `json:"dependencies"`
+}
+
+// Checker is the interface for periodic checking of the dependencies.
+type Checker interface {
+	Run(ctx context.Context) error
+	Ready() bool
+	Status() Status
+}
+
+// checker is the struct that implements Checker interface.
+// Checks are run concurrently, each one limited by timeout.
+type checker struct {
+	checks   []Check
+	interval time.Duration
+	timeout  time.Duration
+
+	mu      sync.RWMutex
+	checked bool
+	states  []State
+}
+
+// NewChecker is the constructor method for checker struct.
+// Non-positive interval and timeout are replaced with 5 seconds and 1 second.
+func NewChecker(checks []Check, interval, timeout time.Duration) *checker {
+	if interval <= 0 {
+		interval = defaultInterval
+	}
+
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+
+	states := make([]State, len(checks))
+	for i, check := range checks {
+		states[i] = State{Name: check.Name, Optional: check.Optional}
+	}
+
+	return &checker{checks: checks, interval: interval, timeout: timeout, states: states}
+}
+
+// Run is the method that checks the dependencies every interval until ctx is done.
+func (c *checker) Run(ctx context.Context) error {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	c.checkAll(ctx)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			c.checkAll(ctx)
+		}
+	}
+}
+
+// checkAll is the method that runs all checks and stores their results.
+func (c *checker) checkAll(ctx context.Context) {
+	states := make([]State, len(c.checks))
+
+	var wg sync.WaitGroup
+	for i, check := range c.checks {
+		wg.Add(1)
+		go func(i int, check Check) {
+			defer wg.Done()
+			states[i] = c.check(ctx, check)
+		}(i, check)
+	}
+	wg.Wait()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for i, state := range states {
+		if state.Healthy != c.states[i].Healthy || !c.checked {
+			if state.Healthy {
+				log.Info().Msgf("dependency %s is healthy", state.Name)
+			} else {
+				log.Warn().Msgf("dependency %s is unhealthy: %s", state.Name, state.Error)
+			}
+		}
+	}
+
+	c.states = states
+	c.checked = true
+}
+
+// check is the method that runs the single check.
+func (c *checker) check(ctx context.Context, check Check) State {
+	checkCtx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	started := time.Now()
+	err := check.Check(checkCtx)
+
+	state := State{
+		Name:      check.Name,
+		Healthy:   err == nil,
+		Optional:  check.Optional,
+		LatencyMs: float64(time.Since(started).Microseconds()) / 1000,
+		CheckedAt: started.UTC(),
+	}
+	if err != nil {
+		state.Error = err.Error()
+	}
+
+	return state
+}
+
+// Ready is the method reporting whether the dependencies are checked
+// and all required ones are healthy.
+func (c *checker) Ready() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.ready()
+}
+
+func (c *checker) ready() bool {
+	if !c.checked {
+		return false
+	}
+
+	for _, state := range c.states {
+		if !state.Healthy && !state.Optional {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Status is the method returning the last results of the checks.
+func (c *checker) Status() Status {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	states := make([]State, len(c.states))
+	copy(states, c.states)
+
+	return Status{Ready: c.ready(), Dependencies: states}
+}
@@ -0,0 +1,121 @@
+package health_test
+
+import (
+	"context"
+	"errors"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/ozoncp/ocp-team-api/internal/health"
+	"sync/atomic"
+	"time"
+)
+
+var _ = Describe("Checker", func() {
+
+	var (
+		ctx    context.Context
+		cancel context.CancelFunc
+	)
+
+	errDown := errors.New("down")
+
+	passing := func(context.Context) error { return nil }
+	failing := func(context.Context) error { return errDown }
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	run := func(checker health.Checker) {
+		go func() {
+			defer GinkgoRecover()
+			Expect(checker.Run(ctx)).Should(BeNil())
+		}()
+	}
+
+	It("is not ready before the first check", func() {
+		checker := health.NewChecker([]health.Check{{Name: "database", Check: passing}}, time.Hour, 0)
+
+		Expect(checker.Ready()).Should(BeFalse())
+		Expect(checker.Status().Dependencies).Should(ConsistOf(
+			health.State{Name: "database"},
+		))
+	})
+
+	It("is ready when all dependencies are healthy", func() {
+		checker := health.NewChecker([]health.Check{
+			{Name: "database", Check: passing},
+			{Name: "kafka", Check: passing},
+		}, time.Hour, 0)
+		run(checker)
+
+		Eventually(checker.Ready).Should(BeTrue())
+		for _, state := range checker.Status().Dependencies {
+			Expect(state.Healthy).Should(BeTrue())
+			Expect(state.CheckedAt).ShouldNot(BeZero())
+		}
+	})
+
+	It("is not ready when the required dependency is unhealthy", func() {
+		checker := health.NewChecker([]health.Check{
+			{Name: "database", Check: passing},
+			{Name: "kafka", Check: failing},
+		}, time.Hour, 0)
+		run(checker)
+
+		Eventually(func() string { return checker.Status().Dependencies[1].Error }).Should(Equal(errDown.Error()))
+		Expect(checker.Ready()).Should(BeFalse())
+		Expect(checker.Status().Ready).Should(BeFalse())
+	})
+
+	It("ignores unhealthy optional dependencies", func() {
+		checker := health.NewChecker([]health.Check{
+			{Name: "database", Check: passing},
+			{Name: "replica-0", Check: failing, Optional: true},
+		}, time.Hour, 0)
+		run(checker)
+
+		Eventually(checker.Ready).Should(BeTrue())
+		Expect(checker.Status().Dependencies[1].Healthy).Should(BeFalse())
+	})
+
+	It("limits the check by timeout", func() {
+		checker := health.NewChecker([]health.Check{{
+			Name: "database",
+			Check: func(ctx context.Context) error {
+				<-ctx.Done()
+				return ctx.Err()
+			},
+		}}, time.Hour, 10*time.Millisecond)
+		run(checker)
+
+		Eventually(func() string { return checker.Status().Dependencies[0].Error }).
+			Should(Equal(context.DeadlineExceeded.Error()))
+	})
+
+	It("repeats checks every interval", func() {
+		var calls int32
+		healthy := int32(0)
+		checker := health.NewChecker([]health.Check{{
+			Name: "database",
+			Check: func(context.Context) error {
+				atomic.AddInt32(&calls, 1)
+				if atomic.LoadInt32(&healthy) == 0 {
+					return errDown
+				}
+				return nil
+			},
+		}}, 10*time.Millisecond, 0)
+		run(checker)
+
+		Eventually(func() int32 { return atomic.LoadInt32(&calls) }).Should(BeNumerically(">=", 1))
+		Expect(checker.Ready()).Should(BeFalse())
+
+		atomic.StoreInt32(&healthy, 1)
+		Eventually(checker.Ready).Should(BeTrue())
+	})
+})
@@ -0,0 +1,255 @@
+package kafka
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/Shopify/sarama"
+	"strconv"
+	"time"
+)
+
+// ContentMode is the CloudEvents content mode used for Kafka messages:
+// structured (whole envelope in the value) or binary (attributes in headers).
+type ContentMode string
+
+const (
+	StructuredMode ContentMode = "structured"
+	BinaryMode     ContentMode = "binary"
+)
+
+const (
+	// CloudEventsSpecVersion is the version of CloudEvents specification supported by the package.
+	CloudEventsSpecVersion = "1.0"
+
+	structuredContentType = "application/cloudevents+json"
+	dataContentType       = "application/json"
+
+	contentTypeHeader = "content-type"
+	headerPrefix      = "ce_"
+)
+
+var eventTypeMapper = map[Event]string{
+	Create:   "ocp.team.created",
+	Update:   "ocp.team.updated",
+	Delete:   "ocp.team.deleted",
+	Snapshot: "ocp.team.snapshot",
+}
+
+// Type is the method for converting Event to the CloudEvents type attribute.
+func (e Event) Type() string {
+	if value, ok := eventTypeMapper[e]; ok {
+		return value
+	}
+
+	return "ocp.team.unknown"
+}
+
+// ParseEventType is the method for converting the CloudEvents type attribute back to Event.
+// It returns error if the type is unknown.
+func ParseEventType(eventType string) (Event, error) {
+	for event, value := range eventTypeMapper {
+		if value == eventType {
+			return event, nil
+		}
+	}
+
+	return 0, fmt.Errorf("unknown cloud event type %q", eventType)
+}
+
+// CloudEvent is the struct that represents CloudEvents 1.0 envelope of the team event.
+type CloudEvent struct {
+	SpecVersion     string          `json:"specversion"`
+	Id              string          `json:"id"`
+	Source          string          `json:"source"`
+	Type            string          `json:"type"`
+	Subject         string          `json:"subject,omitempty"`
+	Time            time.Time       `json:"time"`
+	DataContentType string          `json:"datacontenttype,omitempty"`
+	Data            json.RawMessage `json:"data,omitempty"`
+}
+
+// Event is the method for getting Event from the type attribute.
+func (ce *CloudEvent) Event() (Event, error) {
+	return ParseEventType(ce.Type)
+}
+
+// Message is the method for decoding data of the cloud event into Message.
+func (ce *CloudEvent) Message() (Message, error) {
+	var message Message
+	if err := json.Unmarshal(ce.Data, &message); err != nil {
+		return Message{}, err
+	}
+
+	return message, nil
+}
+
+// Encoder is the interface for converting Message into the broker message.
+type Encoder interface {
+	Encode(topic string, message Message) (*sarama.ProducerMessage, error)
+}
+
+// jsonEncoder is the struct that implements Encoder interface
+// by sending the plain JSON representation of Message.
+type jsonEncoder struct{}
+
+// NewJSONEncoder is the constructor method for jsonEncoder struct.
+func NewJSONEncoder() *jsonEncoder {
+	return &jsonEncoder{}
+}
+
+// Encode is the method that marshals message to JSON.
+func (e *jsonEncoder) Encode(topic string, message Message) (*sarama.ProducerMessage, error) {
+	b, err := json.Marshal(message)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sarama.ProducerMessage{
+		Topic:     topic,
+		Partition: -1,
+		Value:     sarama.StringEncoder(b),
+	}, nil
+}
+
+// cloudEventsEncoder is the struct that implements Encoder interface
+// by wrapping Message into CloudEvents envelope.
+type cloudEventsEncoder struct {
+	mode   ContentMode
+	source string
+	now    func() time.Time
+}
+
+// NewCloudEventsEncoder is the constructor method for cloudEventsEncoder struct.
+// It returns error if mode is unknown or source is empty.
+func NewCloudEventsEncoder(mode ContentMode, source string) (*cloudEventsEncoder, error) {
+	if mode != StructuredMode && mode != BinaryMode {
+		return nil, fmt.Errorf("unknown cloud events content mode %q", mode)
+	}
+
+	if source == "" {
+		return nil, errors.New("cloud events source must not be empty")
+	}
+
+	return &cloudEventsEncoder{mode: mode, source: source, now: time.Now}, nil
+}
+
+// Encode is the method that wraps message into CloudEvents envelope
+// according to the content mode of the encoder.
+func (e *cloudEventsEncoder) Encode(topic string, message Message) (*sarama.ProducerMessage, error) {
+	data, err := json.Marshal(message)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := newEventId()
+	if err != nil {
+		return nil, err
+	}
+
+	ce := CloudEvent{
+		SpecVersion:     CloudEventsSpecVersion,
+		Id:              id,
+		Source:          e.source,
+		Type:            ParseEvent(message.Event).Type(),
+		Subject:         strconv.FormatUint(message.Id, 10),
+		Time:            e.now().UTC(),
+		DataContentType: dataContentType,
+		Data:            data,
+	}
+
+	msg := &sarama.ProducerMessage{
+		Topic:     topic,
+		Partition: -1,
+		Key:       sarama.StringEncoder(ce.Subject),
+	}
+
+	switch e.mode {
+	case StructuredMode:
+		b, err := json.Marshal(ce)
+		if err != nil {
+			return nil, err
+		}
+		msg.Value = sarama.ByteEncoder(b)
+		msg.Headers = []sarama.RecordHeader{header(contentTypeHeader, structuredContentType)}
+	case BinaryMode:
+		msg.Value = sarama.ByteEncoder(data)
+		msg.Headers = []sarama.RecordHeader{
+			header(contentTypeHeader, ce.DataContentType),
+			header(headerPrefix+"specversion", ce.SpecVersion),
+			header(headerPrefix+"id", ce.Id),
+			header(headerPrefix+"source", ce.Source),
+			header(headerPrefix+"type", ce.Type),
+			header(headerPrefix+"subject", ce.Subject),
+			header(headerPrefix+"time", ce.Time.Format(time.RFC3339Nano)),
+		}
+	}
+
+	return msg, nil
+}
+
+// DecodeCloudEvent is the method for parsing consumed message back into CloudEvent.
+// Both structured and binary content modes are supported.
+// It returns error if the message is not a valid CloudEvents 1.0 event.
+func DecodeCloudEvent(msg *sarama.ConsumerMessage) (*CloudEvent, error) {
+	headers := make(map[string]string, len(msg.Headers))
+	for _, h := range msg.Headers {
+		if h != nil {
+			headers[string(h.Key)] = string(h.Value)
+		}
+	}
+
+	var ce CloudEvent
+
+	if specVersion, ok := headers[headerPrefix+"specversion"]; ok {
+		ce.SpecVersion = specVersion
+		ce.Id = headers[headerPrefix+"id"]
+		ce.Source = headers[headerPrefix+"source"]
+		ce.Type = headers[headerPrefix+"type"]
+		ce.Subject = headers[headerPrefix+"subject"]
+		ce.DataContentType = headers[contentTypeHeader]
+		ce.Data = msg.Value
+
+		if value, ok := headers[headerPrefix+"time"]; ok {
+			t, err := time.Parse(time.RFC3339Nano, value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid cloud event time: %w", err)
+			}
+			ce.Time = t
+		}
+	} else if headers[contentTypeHeader] == structuredContentType {
+		if err := json.Unmarshal(msg.Value, &ce); err != nil {
+			return nil, err
+		}
+	} else {
+		return nil, errors.New("message is not a cloud event")
+	}
+
+	if ce.SpecVersion != CloudEventsSpecVersion {
+		return nil, fmt.Errorf("unsupported cloud events spec version %q", ce.SpecVersion)
+	}
+
+	if ce.Id == "" || ce.Source == "" || ce.Type == "" {
+		return nil, errors.New("cloud event misses required attributes")
+	}
+
+	return &ce, nil
+}
+
+func header(key, value string) sarama.RecordHeader {
+	return sarama.RecordHeader{Key: []byte(key), Value: []byte(value)}
+}
+
+// newEventId is the method for generating random (version 4) UUID for the event id.
+func newEventId() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:]), nil
+}
@@ -0,0 +1,77 @@
+package kafka_test
+
+import (
+	"github.com/Shopify/sarama"
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/gomega"
+	"github.com/ozoncp/ocp-team-api/internal/kafka"
+)
+
+// consumed is the helper converting produced message to the consumed one.
+func consumed(msg *sarama.ProducerMessage) *sarama.ConsumerMessage {
+	value, err := msg.Value.Encode()
+	gomega.Expect(err).Should(gomega.BeNil())
+
+	headers := make([]*sarama.RecordHeader, 0, len(msg.Headers))
+	for i := range msg.Headers {
+		headers = append(headers, &msg.Headers[i])
+	}
+
+	return &sarama.ConsumerMessage{Topic: msg.Topic, Value: value, Headers: headers}
+}
+
+var _ = Describe("CloudEvents", func() {
+	message := kafka.NewMessage(42, kafka.Update)
+
+	Context("invalid encoder", func() {
+		It("returns error for unknown content mode", func() {
+			_, err := kafka.NewCloudEventsEncoder("batched", "/ocp-team-api")
+			gomega.Expect(err).ShouldNot(gomega.BeNil())
+		})
+
+		It("returns error for empty source", func() {
+			_, err := kafka.NewCloudEventsEncoder(kafka.StructuredMode, "")
+			gomega.Expect(err).ShouldNot(gomega.BeNil())
+		})
+	})
+
+	for _, mode := range []kafka.ContentMode{kafka.StructuredMode, kafka.BinaryMode} {
+		mode := mode
+
+		It("encodes and decodes events in "+string(mode)+" mode", func() {
+			encoder, err := kafka.NewCloudEventsEncoder(mode, "/ocp-team-api")
+			gomega.Expect(err).Should(gomega.BeNil())
+
+			msg, err := encoder.Encode("team", message)
+			gomega.Expect(err).Should(gomega.BeNil())
+			gomega.Expect(msg.Topic).Should(gomega.Equal("team"))
+
+			ce, err := kafka.DecodeCloudEvent(consumed(msg))
+			gomega.Expect(err).Should(gomega.BeNil())
+			gomega.Expect(ce.SpecVersion).Should(gomega.Equal(kafka.CloudEventsSpecVersion))
+			gomega.Expect(ce.Id).ShouldNot(gomega.BeEmpty())
+			gomega.Expect(ce.Source).Should(gomega.Equal("/ocp-team-api"))
+			gomega.Expect(ce.Type).Should(gomega.Equal("ocp.team.updated"))
+			gomega.Expect(ce.Subject).Should(gomega.Equal("42"))
+			gomega.Expect(ce.Time.IsZero()).Should(gomega.BeFalse())
+
+			event, err := ce.Event()
+			gomega.Expect(err).Should(gomega.BeNil())
+			gomega.Expect(event).Should(gomega.Equal(kafka.Update))
+
+			decoded, err := ce.Message()
+			gomega.Expect(err).Should(gomega.BeNil())
+			gomega.Expect(decoded).Should(gomega.Equal(message))
+		})
+	}
+
+	Context("when message is not a cloud event", func() {
+		It("returns error", func() {
+			msg, err := kafka.NewJSONEncoder().Encode("team", message)
+			gomega.Expect(err).Should(gomega.BeNil())
+
+			_, err = kafka.DecodeCloudEvent(consumed(msg))
+			gomega.Expect(err).ShouldNot(gomega.BeNil())
+		})
+	})
+})
@@ -0,0 +1,106 @@
+package kafka
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/Shopify/sarama"
+	"github.com/ozoncp/ocp-team-api/internal/config"
+	"github.com/rs/zerolog/log"
+	"sync"
+)
+
+// Consumer is the interface for receiving team events from broker.
+type Consumer interface {
+	Run(ctx context.Context, handler func(message Message)) error
+}
+
+// consumer is the struct that implements Consumer interface.
+// Every instance reads all partitions of the topic from the newest offset
+// without the consumer group, so each one receives every event.
+type consumer struct {
+	actor sarama.Consumer
+	topic string
+}
+
+// NewConsumer is the constructor method for consumer struct reading the configured topic.
+// It returns error if such occurred during constructing.
+func NewConsumer() (*consumer, error) {
+	saramaConfig, err := NewSaramaConfig(config.GetInstance().Kafka)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := sarama.NewConsumer(config.GetInstance().Kafka.Brokers, saramaConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return &consumer{actor: c, topic: config.GetInstance().Kafka.Topic}, nil
+}
+
+// Run is the method that passes messages produced after the start to handler
+// until ctx is done. Messages which cannot be decoded are skipped.
+// It returns error if the partitions of the topic cannot be consumed.
+func (c *consumer) Run(ctx context.Context, handler func(message Message)) error {
+	defer c.actor.Close()
+
+	partitions, err := c.actor.Partitions(c.topic)
+	if err != nil {
+		return err
+	}
+
+	var wg sync.WaitGroup
+	defer wg.Wait()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	for _, partition := range partitions {
+		pc, err := c.actor.ConsumePartition(c.topic, partition, sarama.OffsetNewest)
+		if err != nil {
+			return err
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer pc.AsyncClose()
+
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case msg, ok := <-pc.Messages():
+					if !ok {
+						return
+					}
+
+					message, err := DecodeMessage(msg)
+					if err != nil {
+						log.Warn().Err(err).Msgf("cannot decode message at offset %d", msg.Offset)
+						continue
+					}
+					handler(message)
+				}
+			}
+		}()
+	}
+
+	<-ctx.Done()
+	return nil
+}
+
+// DecodeMessage is the method for parsing consumed message back into Message.
+// Both plain JSON and CloudEvents envelopes are supported.
+func DecodeMessage(msg *sarama.ConsumerMessage) (Message, error) {
+	if ce, err := DecodeCloudEvent(msg); err == nil {
+		return ce.Message()
+	}
+
+	var message Message
+	if err := json.Unmarshal(msg.Value, &message); err != nil {
+		return Message{}, err
+	}
+
+	return message, nil
+}
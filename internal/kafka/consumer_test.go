@@ -0,0 +1,42 @@
+package kafka_test
+
+import (
+	"github.com/Shopify/sarama"
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/gomega"
+	"github.com/ozoncp/ocp-team-api/internal/kafka"
+)
+
+var _ = Describe("DecodeMessage", func() {
+	message := kafka.NewMessage(42, kafka.Delete)
+
+	It("decodes plain JSON messages", func() {
+		msg, err := kafka.NewJSONEncoder().Encode("team", message)
+		gomega.Expect(err).Should(gomega.BeNil())
+
+		decoded, err := kafka.DecodeMessage(consumed(msg))
+		gomega.Expect(err).Should(gomega.BeNil())
+		gomega.Expect(decoded).Should(gomega.Equal(message))
+	})
+
+	for _, mode := range []kafka.ContentMode{kafka.StructuredMode, kafka.BinaryMode} {
+		mode := mode
+
+		It("decodes cloud events in "+string(mode)+" mode", func() {
+			encoder, err := kafka.NewCloudEventsEncoder(mode, "/ocp-team-api")
+			gomega.Expect(err).Should(gomega.BeNil())
+
+			msg, err := encoder.Encode("team", message)
+			gomega.Expect(err).Should(gomega.BeNil())
+
+			decoded, err := kafka.DecodeMessage(consumed(msg))
+			gomega.Expect(err).Should(gomega.BeNil())
+			gomega.Expect(decoded).Should(gomega.Equal(message))
+		})
+	}
+
+	It("returns error for invalid messages", func() {
+		_, err := kafka.DecodeMessage(&sarama.ConsumerMessage{Value: []byte("not json")})
+		gomega.Expect(err).ShouldNot(gomega.BeNil())
+	})
+})
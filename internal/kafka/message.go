@@ -1,18 +1,24 @@
 package kafka
 
+import "github.com/ozoncp/ocp-team-api/internal/models"
+
 // Event is the type of action happened: Create, Update, Delete.
+// Snapshot is not an action but the current state of the team republished
+// for rebuilding downstream state.
 type Event int
 
 const (
 	Create Event = iota + 1
 	Update
 	Delete
+	Snapshot
 )
 
 var eventMapper = map[Event]string{
-	Create: "Create",
-	Update: "Update",
-	Delete: "Delete",
+	Create:   "Create",
+	Update:   "Update",
+	Delete:   "Delete",
+	Snapshot: "Snapshot",
 }
 
 // String is the method for converting Event type to corresponding string.
@@ -24,6 +30,18 @@ func (e Event) String() string {
 	return "Unknown"
 }
 
+// ParseEvent is the method for converting string representation back to Event.
+// It returns zero Event if the string is unknown.
+func ParseEvent(value string) Event {
+	for event, name := range eventMapper {
+		if name == value {
+			return event
+		}
+	}
+
+	return 0
+}
+
 // NewMessage is the constructor method for Message struct.
 func NewMessage(Id uint64, Event Event) Message {
 	return Message{
@@ -32,8 +50,22 @@ func NewMessage(Id uint64, Event Event) Message {
 	}
 }
 
+// NewSnapshotMessage is the constructor method for Message struct
+// carrying the current state of the team.
+func NewSnapshotMessage(team models.Team) Message {
+	return Message{
+		Id:          team.Id,
+		Event:       Snapshot.String(),
+		Name:        team.Name,
+		Description: team.Description,
+	}
+}
+
 // Message is the struct that representing message to be sent to broker.
+// Name and Description are filled only for Snapshot events.
 type Message struct {
-	Id    uint64 `json:"id"`
-	Event string `json:"event"`
+	Id          uint64 `json:"id"`
+	Event       string `json:"event"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
 }
@@ -0,0 +1,94 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"github.com/Shopify/sarama"
+	"github.com/ozoncp/ocp-team-api/internal/config"
+	"sync"
+)
+
+// MetadataChecker is the interface for checking that the broker serves the topic.
+type MetadataChecker interface {
+	Check(ctx context.Context) error
+	Close() error
+}
+
+// metadataChecker is the struct that implements MetadataChecker interface.
+// The client is connected on the first check, so the unreachable broker
+// does not prevent the start and is reported by the check instead.
+type metadataChecker struct {
+	mu           sync.Mutex
+	client       sarama.Client
+	brokers      []string
+	topic        string
+	saramaConfig *sarama.Config
+}
+
+// NewMetadataChecker is the constructor method for metadataChecker struct.
+// It returns error if the kafka configuration is invalid.
+func NewMetadataChecker(cfg *config.Kafka) (*metadataChecker, error) {
+	saramaConfig, err := NewSaramaConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	// The check is repeated periodically, so it fails fast instead of retrying.
+	saramaConfig.Metadata.Retry.Max = 0
+
+	return &metadataChecker{brokers: cfg.Brokers, topic: cfg.Topic, saramaConfig: saramaConfig}, nil
+}
+
+// Check is the method that refreshes metadata of the topic.
+// It returns error if no broker answers before ctx is done or the topic has no partitions.
+func (c *metadataChecker) Check(ctx context.Context) error {
+	done := make(chan error, 1)
+	go func() {
+		done <- c.refresh()
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (c *metadataChecker) refresh() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.client == nil {
+		client, err := sarama.NewClient(c.brokers, c.saramaConfig)
+		if err != nil {
+			return err
+		}
+		c.client = client
+	}
+
+	if err := c.client.RefreshMetadata(c.topic); err != nil {
+		return err
+	}
+
+	partitions, err := c.client.Partitions(c.topic)
+	if err != nil {
+		return err
+	}
+	if len(partitions) == 0 {
+		return fmt.Errorf("topic %s has no partitions", c.topic)
+	}
+
+	return nil
+}
+
+// Close is the method that closes the client if it is connected.
+func (c *metadataChecker) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.client == nil {
+		return nil
+	}
+
+	return c.client.Close()
+}
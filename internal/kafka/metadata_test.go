@@ -0,0 +1,55 @@
+package kafka_test
+
+import (
+	"context"
+	"github.com/Shopify/sarama"
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/gomega"
+	"github.com/ozoncp/ocp-team-api/internal/config"
+	"github.com/ozoncp/ocp-team-api/internal/kafka"
+)
+
+var _ = Describe("MetadataChecker", func() {
+
+	var broker *sarama.MockBroker
+
+	BeforeEach(func() {
+		broker = sarama.NewMockBroker(GinkgoT(), 1)
+	})
+
+	AfterEach(func() {
+		broker.Close()
+	})
+
+	check := func(topic string) error {
+		checker, err := kafka.NewMetadataChecker(&config.Kafka{Topic: topic, Brokers: []string{broker.Addr()}})
+		gomega.Expect(err).Should(gomega.BeNil())
+		defer checker.Close()
+
+		return checker.Check(context.Background())
+	}
+
+	It("passes when the topic has partitions", func() {
+		broker.SetHandlerByMap(map[string]sarama.MockResponse{
+			"MetadataRequest": sarama.NewMockMetadataResponse(GinkgoT()).
+				SetBroker(broker.Addr(), broker.BrokerID()).
+				SetLeader("team", 0, broker.BrokerID()),
+		})
+
+		gomega.Expect(check("team")).Should(gomega.BeNil())
+	})
+
+	It("fails when the topic is unknown", func() {
+		broker.SetHandlerByMap(map[string]sarama.MockResponse{
+			"MetadataRequest": sarama.NewMockMetadataResponse(GinkgoT()).
+				SetBroker(broker.Addr(), broker.BrokerID()),
+		})
+
+		gomega.Expect(check("team")).ShouldNot(gomega.BeNil())
+	})
+
+	It("returns error for invalid config", func() {
+		_, err := kafka.NewMetadataChecker(&config.Kafka{Topic: "team"})
+		gomega.Expect(err).ShouldNot(gomega.BeNil())
+	})
+})
@@ -1,7 +1,6 @@
 package kafka
 
 import (
-	"encoding/json"
 	"github.com/Shopify/sarama"
 	"github.com/ozoncp/ocp-team-api/internal/config"
 )
@@ -13,28 +12,56 @@ type Producer interface {
 
 // producer is the struct that implements Producer interface.
 type producer struct {
-	actor sarama.SyncProducer
-	topic string
+	actor   sarama.SyncProducer
+	topic   string
+	encoder Encoder
 }
 
 // NewProducer is the constructor method for producer struct.
 // It returns error if such occurred during constructing.
 func NewProducer() (*producer, error) {
-	saramaConfig := sarama.NewConfig()
-	saramaConfig.Producer.Partitioner = sarama.NewRandomPartitioner
-	saramaConfig.Producer.RequiredAcks = sarama.WaitForAll
-	saramaConfig.Producer.Return.Successes = true
+	return NewProducerWithTopic(config.GetInstance().Kafka.Topic)
+}
+
+// NewProducerWithTopic is the constructor method for producer struct
+// sending messages to the given topic instead of the configured one.
+// It returns error if such occurred during constructing.
+func NewProducerWithTopic(topic string) (*producer, error) {
+	encoder, err := newEncoder(config.GetInstance().Kafka.CloudEvents)
+	if err != nil {
+		return nil, err
+	}
+
+	saramaConfig, err := NewSaramaConfig(config.GetInstance().Kafka)
+	if err != nil {
+		return nil, err
+	}
 
 	p, err := sarama.NewSyncProducer(config.GetInstance().Kafka.Brokers, saramaConfig)
 
-	return &producer{actor: p, topic: config.GetInstance().Kafka.Topic}, err
+	return &producer{actor: p, topic: topic, encoder: encoder}, err
+}
+
+// newEncoder is the method for choosing message encoder according to
+// the cloud events settings. Plain JSON encoder is used when they are disabled.
+func newEncoder(cfg *config.CloudEvents) (Encoder, error) {
+	if cfg == nil || !cfg.Enabled {
+		return NewJSONEncoder(), nil
+	}
+
+	mode := ContentMode(cfg.Mode)
+	if mode == "" {
+		mode = StructuredMode
+	}
+
+	return NewCloudEventsEncoder(mode, cfg.Source)
 }
 
 // Send is the method that sends message to the broker.
 // It returns error if such occurred during either
 // message preparing or sending.
 func (p *producer) Send(message Message) error {
-	msg, err := prepareMessage(message)
+	msg, err := p.encoder.Encode(p.topic, message)
 	if err != nil {
 		return err
 	}
@@ -43,18 +70,3 @@ func (p *producer) Send(message Message) error {
 
 	return err
 }
-
-func prepareMessage(message Message) (*sarama.ProducerMessage, error) {
-	b, err := json.Marshal(message)
-	if err != nil {
-		return nil, err
-	}
-
-	msg := &sarama.ProducerMessage{
-		Topic:     config.GetInstance().Kafka.Topic,
-		Partition: -1,
-		Value:     sarama.StringEncoder(b),
-	}
-
-	return msg, nil
-}
@@ -0,0 +1,148 @@
+package kafka
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"github.com/Shopify/sarama"
+	"github.com/ozoncp/ocp-team-api/internal/config"
+	"io/ioutil"
+	"strings"
+)
+
+var compressionMapper = map[string]sarama.CompressionCodec{
+	"":       sarama.CompressionNone,
+	"none":   sarama.CompressionNone,
+	"gzip":   sarama.CompressionGZIP,
+	"snappy": sarama.CompressionSnappy,
+	"lz4":    sarama.CompressionLZ4,
+	"zstd":   sarama.CompressionZSTD,
+}
+
+// NewSaramaConfig is the method for building producer settings from the kafka configuration.
+// It returns error describing the first inconsistency found in the configuration.
+func NewSaramaConfig(cfg *config.Kafka) (*sarama.Config, error) {
+	if cfg == nil {
+		return nil, fmt.Errorf("invalid kafka config: section is missing")
+	}
+
+	if len(cfg.Brokers) == 0 {
+		return nil, fmt.Errorf("invalid kafka config: brokers must not be empty")
+	}
+
+	saramaConfig := sarama.NewConfig()
+	saramaConfig.Producer.Partitioner = sarama.NewRandomPartitioner
+	saramaConfig.Producer.RequiredAcks = sarama.WaitForAll
+	saramaConfig.Producer.Return.Successes = true
+
+	if cfg.ClientId != "" {
+		saramaConfig.ClientID = cfg.ClientId
+	}
+
+	codec, ok := compressionMapper[strings.ToLower(cfg.Compression)]
+	if !ok {
+		return nil, fmt.Errorf(
+			"invalid kafka config: compression %q is not supported (use none, gzip, snappy, lz4 or zstd)",
+			cfg.Compression,
+		)
+	}
+	saramaConfig.Producer.Compression = codec
+	if codec == sarama.CompressionZSTD {
+		saramaConfig.Version = sarama.V2_1_0_0
+	}
+
+	if err := configureTLS(saramaConfig, cfg.TLS); err != nil {
+		return nil, fmt.Errorf("invalid kafka config: %w", err)
+	}
+
+	if err := configureSASL(saramaConfig, cfg.SASL); err != nil {
+		return nil, fmt.Errorf("invalid kafka config: %w", err)
+	}
+
+	if err := saramaConfig.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid kafka config: %w", err)
+	}
+
+	return saramaConfig, nil
+}
+
+func configureTLS(saramaConfig *sarama.Config, cfg *config.KafkaTLS) error {
+	if cfg == nil || !cfg.Enabled {
+		if cfg != nil && (cfg.CAFile != "" || cfg.CertFile != "" || cfg.KeyFile != "") {
+			return fmt.Errorf("tls files are set but tls is disabled")
+		}
+		return nil
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: cfg.InsecureSkipVerify,
+	}
+
+	if cfg.CAFile != "" {
+		pem, err := ioutil.ReadFile(cfg.CAFile)
+		if err != nil {
+			return fmt.Errorf("cannot read tls ca_file: %w", err)
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return fmt.Errorf("tls ca_file %s contains no valid certificates", cfg.CAFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if (cfg.CertFile == "") != (cfg.KeyFile == "") {
+		return fmt.Errorf("tls cert_file and key_file must be set together")
+	}
+
+	if cfg.CertFile != "" {
+		cert, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+		if err != nil {
+			return fmt.Errorf("cannot load tls client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	saramaConfig.Net.TLS.Enable = true
+	saramaConfig.Net.TLS.Config = tlsConfig
+
+	return nil
+}
+
+func configureSASL(saramaConfig *sarama.Config, cfg *config.KafkaSASL) error {
+	if cfg == nil || !cfg.Enabled {
+		return nil
+	}
+
+	if cfg.User == "" || cfg.Password == "" {
+		return fmt.Errorf("sasl user and password must not be empty")
+	}
+
+	saramaConfig.Net.SASL.Enable = true
+	saramaConfig.Net.SASL.Handshake = true
+	saramaConfig.Net.SASL.User = cfg.User
+	saramaConfig.Net.SASL.Password = cfg.Password
+
+	switch mechanism := sarama.SASLMechanism(strings.ToUpper(cfg.Mechanism)); mechanism {
+	case sarama.SASLTypePlaintext, "":
+		saramaConfig.Net.SASL.Mechanism = sarama.SASLTypePlaintext
+	case sarama.SASLTypeSCRAMSHA256:
+		saramaConfig.Net.SASL.Mechanism = mechanism
+		saramaConfig.Net.SASL.SCRAMClientGeneratorFunc = func() sarama.SCRAMClient {
+			return &scramClient{hashGenerator: sha256HashGenerator}
+		}
+	case sarama.SASLTypeSCRAMSHA512:
+		saramaConfig.Net.SASL.Mechanism = mechanism
+		saramaConfig.Net.SASL.SCRAMClientGeneratorFunc = func() sarama.SCRAMClient {
+			return &scramClient{hashGenerator: sha512HashGenerator}
+		}
+	default:
+		return fmt.Errorf(
+			"sasl mechanism %q is not supported (use PLAIN, SCRAM-SHA-256 or SCRAM-SHA-512)",
+			cfg.Mechanism,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package kafka_test
+
+import (
+	"github.com/Shopify/sarama"
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/gomega"
+	"github.com/ozoncp/ocp-team-api/internal/config"
+	"github.com/ozoncp/ocp-team-api/internal/kafka"
+)
+
+var _ = Describe("NewSaramaConfig", func() {
+	var cfg *config.Kafka
+
+	BeforeEach(func() {
+		cfg = &config.Kafka{Topic: "team", Brokers: []string{"localhost:9094"}}
+	})
+
+	Context("valid config", func() {
+		It("builds plain producer config", func() {
+			saramaConfig, err := kafka.NewSaramaConfig(cfg)
+			gomega.Expect(err).Should(gomega.BeNil())
+			gomega.Expect(saramaConfig.Net.TLS.Enable).Should(gomega.BeFalse())
+			gomega.Expect(saramaConfig.Net.SASL.Enable).Should(gomega.BeFalse())
+		})
+
+		It("builds SCRAM over TLS config", func() {
+			cfg.ClientId = "ocp-team-api"
+			cfg.Compression = "snappy"
+			cfg.TLS = &config.KafkaTLS{Enabled: true, InsecureSkipVerify: true}
+			cfg.SASL = &config.KafkaSASL{Enabled: true, Mechanism: "scram-sha-256", User: "user", Password: "pass"}
+
+			saramaConfig, err := kafka.NewSaramaConfig(cfg)
+			gomega.Expect(err).Should(gomega.BeNil())
+			gomega.Expect(saramaConfig.ClientID).Should(gomega.Equal("ocp-team-api"))
+			gomega.Expect(saramaConfig.Producer.Compression).Should(gomega.Equal(sarama.CompressionSnappy))
+			gomega.Expect(saramaConfig.Net.TLS.Enable).Should(gomega.BeTrue())
+			gomega.Expect(saramaConfig.Net.SASL.Mechanism).Should(gomega.Equal(sarama.SASLMechanism(sarama.SASLTypeSCRAMSHA256)))
+			gomega.Expect(saramaConfig.Net.SASL.SCRAMClientGeneratorFunc()).ShouldNot(gomega.BeNil())
+		})
+	})
+
+	Context("inconsistent config", func() {
+		It("fails without brokers", func() {
+			cfg.Brokers = nil
+		})
+
+		It("fails on unknown compression", func() {
+			cfg.Compression = "brotli"
+		})
+
+		It("fails on unknown SASL mechanism", func() {
+			cfg.SASL = &config.KafkaSASL{Enabled: true, Mechanism: "GSSAPI", User: "user", Password: "pass"}
+		})
+
+		It("fails on SASL without credentials", func() {
+			cfg.SASL = &config.KafkaSASL{Enabled: true, Mechanism: "PLAIN"}
+		})
+
+		It("fails on client certificate without key", func() {
+			cfg.TLS = &config.KafkaTLS{Enabled: true, CertFile: "client.pem"}
+		})
+
+		It("fails on missing CA file", func() {
+			cfg.TLS = &config.KafkaTLS{Enabled: true, CAFile: "/nonexistent/ca.pem"}
+		})
+
+		AfterEach(func() {
+			_, err := kafka.NewSaramaConfig(cfg)
+			gomega.Expect(err).ShouldNot(gomega.BeNil())
+		})
+	})
+})
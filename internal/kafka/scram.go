@@ -0,0 +1,41 @@
+package kafka
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"github.com/xdg/scram"
+)
+
+var (
+	sha256HashGenerator scram.HashGeneratorFcn = sha256.New
+	sha512HashGenerator scram.HashGeneratorFcn = sha512.New
+)
+
+// scramClient is the struct that implements sarama.SCRAMClient interface
+// for SCRAM-SHA-256 and SCRAM-SHA-512 SASL mechanisms.
+type scramClient struct {
+	*scram.ClientConversation
+	hashGenerator scram.HashGeneratorFcn
+}
+
+// Begin is the method that starts new SCRAM conversation.
+func (c *scramClient) Begin(userName, password, authzID string) error {
+	client, err := c.hashGenerator.NewClient(userName, password, authzID)
+	if err != nil {
+		return err
+	}
+
+	c.ClientConversation = client.NewConversation()
+
+	return nil
+}
+
+// Step is the method that handles the next server challenge.
+func (c *scramClient) Step(challenge string) (string, error) {
+	return c.ClientConversation.Step(challenge)
+}
+
+// Done is the method that reports whether the conversation is finished.
+func (c *scramClient) Done() bool {
+	return c.ClientConversation.Done()
+}
@@ -1,6 +1,9 @@
 package metrics
 
-import "github.com/prometheus/client_golang/prometheus"
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"time"
+)
 
 var (
 	createSuccessCounter = prometheus.NewCounter(
@@ -33,6 +36,88 @@ var (
 			Help: "Number of total incoming requests",
 		},
 	)
+	flushChunkDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "ocp_team_api_flush_chunk_duration_seconds",
+			Help:    "Duration of flushing single chunk of teams including retries",
+			Buckets: prometheus.DefBuckets,
+		},
+		[]string{"status"},
+	)
+	saverQueueDepth = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "ocp_team_api_saver_queue_depth",
+			Help: "Number of teams waiting in the saver queue",
+		},
+	)
+	saverBufferFillRatio = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "ocp_team_api_saver_buffer_fill_ratio",
+			Help: "Ratio of teams in the saver buffer to its capacity",
+		},
+	)
+	saverFlushCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "ocp_team_api_saver_flushes_total",
+			Help: "Number of saver flushes by trigger",
+		},
+		[]string{"trigger"},
+	)
+	saverFlushDuration = prometheus.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "ocp_team_api_saver_flush_duration_seconds",
+			Help:    "Duration of flushing the saver buffer",
+			Buckets: prometheus.DefBuckets,
+		},
+	)
+	flusherItemsCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "ocp_team_api_flusher_items_total",
+			Help: "Number of flushed teams by result (created, failed or rejected)",
+		},
+		[]string{"result"},
+	)
+	flusherRetriesCounter = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "ocp_team_api_flusher_retries_total",
+			Help: "Number of chunk retries after transient errors",
+		},
+	)
+	deadLetterSize = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "ocp_team_api_dead_letter_size",
+			Help: "Number of teams in the dead-letter store",
+		},
+	)
+	cacheHitsCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "ocp_team_api_cache_hits_total",
+			Help: "Number of lookups served by the team cache by kind (team or list)",
+		},
+		[]string{"kind"},
+	)
+	cacheMissesCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "ocp_team_api_cache_misses_total",
+			Help: "Number of lookups missed the team cache by kind (team or list)",
+		},
+		[]string{"kind"},
+	)
+	repoCallDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "ocp_team_api_repo_call_duration_seconds",
+			Help:    "Duration of team repo calls by method",
+			Buckets: prometheus.DefBuckets,
+		},
+		[]string{"method"},
+	)
+	repoErrorsCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "ocp_team_api_repo_errors_total",
+			Help: "Number of failed team repo calls by method",
+		},
+		[]string{"method"},
+	)
 )
 
 func Register() {
@@ -42,6 +127,23 @@ func Register() {
 
 	prometheus.MustRegister(invalidRequestsCounter)
 	prometheus.MustRegister(totalRequestsCounter)
+
+	prometheus.MustRegister(flushChunkDuration)
+	prometheus.MustRegister(flusherItemsCounter)
+	prometheus.MustRegister(flusherRetriesCounter)
+
+	prometheus.MustRegister(saverQueueDepth)
+	prometheus.MustRegister(saverBufferFillRatio)
+	prometheus.MustRegister(saverFlushCounter)
+	prometheus.MustRegister(saverFlushDuration)
+
+	prometheus.MustRegister(deadLetterSize)
+
+	prometheus.MustRegister(cacheHitsCounter)
+	prometheus.MustRegister(cacheMissesCounter)
+
+	prometheus.MustRegister(repoCallDuration)
+	prometheus.MustRegister(repoErrorsCounter)
 }
 
 func IncCreateSuccessCounter() {
@@ -63,3 +165,54 @@ func IncInvalidRequestsCounter() {
 func IncTotalRequestsCounter() {
 	totalRequestsCounter.Inc()
 }
+
+func ObserveFlushChunkDuration(duration time.Duration, success bool) {
+	status := "success"
+	if !success {
+		status = "error"
+	}
+
+	flushChunkDuration.WithLabelValues(status).Observe(duration.Seconds())
+}
+
+func AddFlusherItems(created, failed, rejected int) {
+	flusherItemsCounter.WithLabelValues("created").Add(float64(created))
+	flusherItemsCounter.WithLabelValues("failed").Add(float64(failed))
+	flusherItemsCounter.WithLabelValues("rejected").Add(float64(rejected))
+}
+
+func IncFlusherRetriesCounter() {
+	flusherRetriesCounter.Inc()
+}
+
+func SetSaverQueueDepth(depth int) {
+	saverQueueDepth.Set(float64(depth))
+}
+
+func SetSaverBufferFillRatio(size, capacity uint) {
+	saverBufferFillRatio.Set(float64(size) / float64(capacity))
+}
+
+func ObserveSaverFlush(trigger string, duration time.Duration) {
+	saverFlushCounter.WithLabelValues(trigger).Inc()
+	saverFlushDuration.Observe(duration.Seconds())
+}
+
+func SetDeadLetterSize(size uint64) {
+	deadLetterSize.Set(float64(size))
+}
+
+func IncCacheHits(kind string) {
+	cacheHitsCounter.WithLabelValues(kind).Inc()
+}
+
+func IncCacheMisses(kind string) {
+	cacheMissesCounter.WithLabelValues(kind).Inc()
+}
+
+func ObserveRepoCall(method string, duration time.Duration, failed bool) {
+	repoCallDuration.WithLabelValues(method).Observe(duration.Seconds())
+	if failed {
+		repoErrorsCounter.WithLabelValues(method).Inc()
+	}
+}
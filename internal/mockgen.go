@@ -1,5 +1,9 @@
 package internal
 
 //go:generate mockgen -destination=./mocks/repo_mock.go -package=mocks github.com/ozoncp/ocp-team-api/internal/repo Repo
+//go:generate mockgen -destination=./mocks/webhook_repo_mock.go -package=mocks github.com/ozoncp/ocp-team-api/internal/repo WebhookRepo
+//go:generate mockgen -destination=./mocks/dead_letter_repo_mock.go -package=mocks github.com/ozoncp/ocp-team-api/internal/repo DeadLetterRepo
 //go:generate mockgen -destination=./mocks/flusher_mock.go -package=mocks github.com/ozoncp/ocp-team-api/internal/flusher Flusher
 //go:generate mockgen -destination=./mocks/producer_mock.go -package=mocks github.com/ozoncp/ocp-team-api/internal/kafka Producer
+//go:generate mockgen -destination=./mocks/saver_mock.go -package=mocks github.com/ozoncp/ocp-team-api/internal/saver Saver
+//go:generate mockgen -destination=./mocks/replayer_mock.go -package=mocks github.com/ozoncp/ocp-team-api/internal/flusher Replayer
@@ -0,0 +1,125 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/ozoncp/ocp-team-api/internal/repo (interfaces: DeadLetterRepo)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	models "github.com/ozoncp/ocp-team-api/internal/models"
+)
+
+// MockDeadLetterRepo is a mock of DeadLetterRepo interface.
+type MockDeadLetterRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeadLetterRepoMockRecorder
+}
+
+// MockDeadLetterRepoMockRecorder is the mock recorder for MockDeadLetterRepo.
+type MockDeadLetterRepoMockRecorder struct {
+	mock *MockDeadLetterRepo
+}
+
+// NewMockDeadLetterRepo creates a new mock instance.
+func NewMockDeadLetterRepo(ctrl *gomock.Controller) *MockDeadLetterRepo {
+	mock := &MockDeadLetterRepo{ctrl: ctrl}
+	mock.recorder = &MockDeadLetterRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeadLetterRepo) EXPECT() *MockDeadLetterRepoMockRecorder {
+	return m.recorder
+}
+
+// AddDeadLetter mocks base method.
+func (m *MockDeadLetterRepo) AddDeadLetter(arg0 context.Context, arg1 *models.DeadLetter) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddDeadLetter", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddDeadLetter indicates an expected call of AddDeadLetter.
+func (mr *MockDeadLetterRepoMockRecorder) AddDeadLetter(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddDeadLetter", reflect.TypeOf((*MockDeadLetterRepo)(nil).AddDeadLetter), arg0, arg1)
+}
+
+// CountDeadLetters mocks base method.
+func (m *MockDeadLetterRepo) CountDeadLetters(arg0 context.Context) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountDeadLetters", arg0)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountDeadLetters indicates an expected call of CountDeadLetters.
+func (mr *MockDeadLetterRepoMockRecorder) CountDeadLetters(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountDeadLetters", reflect.TypeOf((*MockDeadLetterRepo)(nil).CountDeadLetters), arg0)
+}
+
+// GetDeadLetter mocks base method.
+func (m *MockDeadLetterRepo) GetDeadLetter(arg0 context.Context, arg1 uint64) (*models.DeadLetter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeadLetter", arg0, arg1)
+	ret0, _ := ret[0].(*models.DeadLetter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeadLetter indicates an expected call of GetDeadLetter.
+func (mr *MockDeadLetterRepoMockRecorder) GetDeadLetter(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeadLetter", reflect.TypeOf((*MockDeadLetterRepo)(nil).GetDeadLetter), arg0, arg1)
+}
+
+// ListDeadLetters mocks base method.
+func (m *MockDeadLetterRepo) ListDeadLetters(arg0 context.Context, arg1, arg2 uint64) ([]models.DeadLetter, uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeadLetters", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]models.DeadLetter)
+	ret1, _ := ret[1].(uint64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListDeadLetters indicates an expected call of ListDeadLetters.
+func (mr *MockDeadLetterRepoMockRecorder) ListDeadLetters(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeadLetters", reflect.TypeOf((*MockDeadLetterRepo)(nil).ListDeadLetters), arg0, arg1, arg2)
+}
+
+// RemoveDeadLetter mocks base method.
+func (m *MockDeadLetterRepo) RemoveDeadLetter(arg0 context.Context, arg1 uint64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveDeadLetter", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveDeadLetter indicates an expected call of RemoveDeadLetter.
+func (mr *MockDeadLetterRepoMockRecorder) RemoveDeadLetter(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveDeadLetter", reflect.TypeOf((*MockDeadLetterRepo)(nil).RemoveDeadLetter), arg0, arg1)
+}
+
+// UpdateDeadLetter mocks base method.
+func (m *MockDeadLetterRepo) UpdateDeadLetter(arg0 context.Context, arg1 *models.DeadLetter) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDeadLetter", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateDeadLetter indicates an expected call of UpdateDeadLetter.
+func (mr *MockDeadLetterRepoMockRecorder) UpdateDeadLetter(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDeadLetter", reflect.TypeOf((*MockDeadLetterRepo)(nil).UpdateDeadLetter), arg0, arg1)
+}
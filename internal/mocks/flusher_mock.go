@@ -9,6 +9,7 @@ import (
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
+	flusher "github.com/ozoncp/ocp-team-api/internal/flusher"
 	models "github.com/ozoncp/ocp-team-api/internal/models"
 )
 
@@ -36,10 +37,10 @@ func (m *MockFlusher) EXPECT() *MockFlusherMockRecorder {
 }
 
 // Flush mocks base method.
-func (m *MockFlusher) Flush(arg0 context.Context, arg1 []models.Team) []models.Team {
+func (m *MockFlusher) Flush(arg0 context.Context, arg1 []models.Team) flusher.Result {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Flush", arg0, arg1)
-	ret0, _ := ret[0].([]models.Team)
+	ret0, _ := ret[0].(flusher.Result)
 	return ret0
 }
 
@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/ozoncp/ocp-team-api/internal/flusher (interfaces: Replayer)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	models "github.com/ozoncp/ocp-team-api/internal/models"
+)
+
+// MockReplayer is a mock of Replayer interface.
+type MockReplayer struct {
+	ctrl     *gomock.Controller
+	recorder *MockReplayerMockRecorder
+}
+
+// MockReplayerMockRecorder is the mock recorder for MockReplayer.
+type MockReplayerMockRecorder struct {
+	mock *MockReplayer
+}
+
+// NewMockReplayer creates a new mock instance.
+func NewMockReplayer(ctrl *gomock.Controller) *MockReplayer {
+	mock := &MockReplayer{ctrl: ctrl}
+	mock.recorder = &MockReplayerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReplayer) EXPECT() *MockReplayerMockRecorder {
+	return m.recorder
+}
+
+// Replay mocks base method.
+func (m *MockReplayer) Replay(arg0 context.Context, arg1 []models.Team, arg2 []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Replay", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Replay indicates an expected call of Replay.
+func (mr *MockReplayerMockRecorder) Replay(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Replay", reflect.TypeOf((*MockReplayer)(nil).Replay), arg0, arg1, arg2)
+}
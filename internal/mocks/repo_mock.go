@@ -10,7 +10,7 @@ import (
 
 	gomock "github.com/golang/mock/gomock"
 	models "github.com/ozoncp/ocp-team-api/internal/models"
-	utils "github.com/ozoncp/ocp-team-api/internal/utils"
+	repo "github.com/ozoncp/ocp-team-api/internal/repo"
 )
 
 // MockRepo is a mock of Repo interface.
@@ -36,6 +36,21 @@ func (m *MockRepo) EXPECT() *MockRepoMockRecorder {
 	return m.recorder
 }
 
+// BulkCreateTeams mocks base method.
+func (m *MockRepo) BulkCreateTeams(arg0 context.Context, arg1 []models.Team) ([]uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BulkCreateTeams", arg0, arg1)
+	ret0, _ := ret[0].([]uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BulkCreateTeams indicates an expected call of BulkCreateTeams.
+func (mr *MockRepoMockRecorder) BulkCreateTeams(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkCreateTeams", reflect.TypeOf((*MockRepo)(nil).BulkCreateTeams), arg0, arg1)
+}
+
 // CountTeams mocks base method.
 func (m *MockRepo) CountTeams(arg0 context.Context) (uint64, error) {
 	m.ctrl.T.Helper()
@@ -111,6 +126,21 @@ func (mr *MockRepoMockRecorder) ListTeams(arg0, arg1, arg2 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTeams", reflect.TypeOf((*MockRepo)(nil).ListTeams), arg0, arg1, arg2)
 }
 
+// ListTeamsAfter mocks base method.
+func (m *MockRepo) ListTeamsAfter(arg0 context.Context, arg1, arg2 uint64) ([]models.Team, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTeamsAfter", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]models.Team)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTeamsAfter indicates an expected call of ListTeamsAfter.
+func (mr *MockRepoMockRecorder) ListTeamsAfter(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTeamsAfter", reflect.TypeOf((*MockRepo)(nil).ListTeamsAfter), arg0, arg1, arg2)
+}
+
 // RemoveTeam mocks base method.
 func (m *MockRepo) RemoveTeam(arg0 context.Context, arg1 uint64) error {
 	m.ctrl.T.Helper()
@@ -126,18 +156,33 @@ func (mr *MockRepoMockRecorder) RemoveTeam(arg0, arg1 interface{}) *gomock.Call
 }
 
 // SearchTeams mocks base method.
-func (m *MockRepo) SearchTeams(arg0 context.Context, arg1 string, arg2 utils.SearchType) ([]models.Team, error) {
+func (m *MockRepo) SearchTeams(arg0 context.Context, arg1 repo.SearchQuery) (*repo.SearchResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SearchTeams", arg0, arg1, arg2)
-	ret0, _ := ret[0].([]models.Team)
+	ret := m.ctrl.Call(m, "SearchTeams", arg0, arg1)
+	ret0, _ := ret[0].(*repo.SearchResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // SearchTeams indicates an expected call of SearchTeams.
-func (mr *MockRepoMockRecorder) SearchTeams(arg0, arg1, arg2 interface{}) *gomock.Call {
+func (mr *MockRepoMockRecorder) SearchTeams(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchTeams", reflect.TypeOf((*MockRepo)(nil).SearchTeams), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchTeams", reflect.TypeOf((*MockRepo)(nil).SearchTeams), arg0, arg1)
+}
+
+// SuggestTeams mocks base method.
+func (m *MockRepo) SuggestTeams(arg0 context.Context, arg1 string, arg2 uint64) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuggestTeams", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SuggestTeams indicates an expected call of SuggestTeams.
+func (mr *MockRepoMockRecorder) SuggestTeams(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuggestTeams", reflect.TypeOf((*MockRepo)(nil).SuggestTeams), arg0, arg1, arg2)
 }
 
 // UpdateTeam mocks base method.
@@ -153,3 +198,37 @@ func (mr *MockRepoMockRecorder) UpdateTeam(arg0, arg1 interface{}) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTeam", reflect.TypeOf((*MockRepo)(nil).UpdateTeam), arg0, arg1)
 }
+
+// UpsertTeams mocks base method.
+func (m *MockRepo) UpsertTeams(arg0 context.Context, arg1 []models.Team) ([]repo.UpsertResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertTeams", arg0, arg1)
+	ret0, _ := ret[0].([]repo.UpsertResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertTeams indicates an expected call of UpsertTeams.
+func (mr *MockRepoMockRecorder) UpsertTeams(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertTeams", reflect.TypeOf((*MockRepo)(nil).UpsertTeams), arg0, arg1)
+}
+
+// WithTx mocks base method.
+func (m *MockRepo) WithTx(arg0 context.Context, arg1 func(repo.Repo) error, arg2 ...repo.TxOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "WithTx", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WithTx indicates an expected call of WithTx.
+func (mr *MockRepoMockRecorder) WithTx(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithTx", reflect.TypeOf((*MockRepo)(nil).WithTx), varargs...)
+}
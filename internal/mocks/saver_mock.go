@@ -0,0 +1,93 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/ozoncp/ocp-team-api/internal/saver (interfaces: Saver)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	models "github.com/ozoncp/ocp-team-api/internal/models"
+)
+
+// MockSaver is a mock of Saver interface.
+type MockSaver struct {
+	ctrl     *gomock.Controller
+	recorder *MockSaverMockRecorder
+}
+
+// MockSaverMockRecorder is the mock recorder for MockSaver.
+type MockSaverMockRecorder struct {
+	mock *MockSaver
+}
+
+// NewMockSaver creates a new mock instance.
+func NewMockSaver(ctrl *gomock.Controller) *MockSaver {
+	mock := &MockSaver{ctrl: ctrl}
+	mock.recorder = &MockSaverMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSaver) EXPECT() *MockSaverMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockSaver) Close(arg0 context.Context) ([]models.Team, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close", arg0)
+	ret0, _ := ret[0].([]models.Team)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockSaverMockRecorder) Close(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockSaver)(nil).Close), arg0)
+}
+
+// Flush mocks base method.
+func (m *MockSaver) Flush(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Flush", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Flush indicates an expected call of Flush.
+func (mr *MockSaverMockRecorder) Flush(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Flush", reflect.TypeOf((*MockSaver)(nil).Flush), arg0)
+}
+
+// Save mocks base method.
+func (m *MockSaver) Save(arg0 context.Context, arg1 models.Team) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockSaverMockRecorder) Save(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockSaver)(nil).Save), arg0, arg1)
+}
+
+// SaveTracked mocks base method.
+func (m *MockSaver) SaveTracked(arg0 context.Context, arg1 models.Team, arg2 models.OperationRef) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveTracked", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveTracked indicates an expected call of SaveTracked.
+func (mr *MockSaverMockRecorder) SaveTracked(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveTracked", reflect.TypeOf((*MockSaver)(nil).SaveTracked), arg0, arg1, arg2)
+}
@@ -0,0 +1,25 @@
+package models
+
+import (
+	"fmt"
+	"time"
+)
+
+// DeadLetter is the representation of the team that could not be persisted.
+// Error is the last error the team failed with, Attempts is the number of failed attempts.
+// Team has id when it is created but not written to the sinks, Sinks are the names of
+// the sinks it is not written to; empty Sinks of such team means all the sinks.
+type DeadLetter struct {
+	Id        uint64    `db:"id"`
+	Team      Team      `db:"-"`
+	Sinks     []string  `db:"-"`
+	Error     string    `db:"error"`
+	Attempts  uint32    `db:"attempts"`
+	CreatedAt time.Time `db:"created_at"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+// String is the method for converting DeadLetter struct to string representation.
+func (d DeadLetter) String() string {
+	return fmt.Sprintf("{Id: %d, Team: %s, Sinks: %v, Error: %s, Attempts: %d}", d.Id, d.Team, d.Sinks, d.Error, d.Attempts)
+}
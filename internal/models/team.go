@@ -9,10 +9,18 @@ type Team struct {
 	Id          uint64 `db:"id"`
 	Name        string `db:"name"`
 	Description string `db:"description"`
+	ExternalId  string `db:"external_id"`
 	IsDeleted   bool   `db:"is_deleted"`
 }
 
+// OperationRef is the reference to the position of the team in the asynchronous operation.
+type OperationRef struct {
+	Id    string
+	Index int
+}
+
 // String is the method for converting Team struct to string representation.
 func (t Team) String() string {
-	return fmt.Sprintf("{Id: %d, Name: %s, Description: %s, IsDeleted: %t}", t.Id, t.Name, t.Description, t.IsDeleted)
+	return fmt.Sprintf("{Id: %d, Name: %s, Description: %s, ExternalId: %s, IsDeleted: %t}",
+		t.Id, t.Name, t.Description, t.ExternalId, t.IsDeleted)
 }
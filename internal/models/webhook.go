@@ -0,0 +1,52 @@
+package models
+
+import (
+	"fmt"
+	"time"
+)
+
+// Webhook is the representation of the webhook subscription.
+// Empty Events means the subscription receives all events.
+type Webhook struct {
+	Id        uint64   `db:"id"`
+	URL       string   `db:"url"`
+	Events    []string `db:"events"`
+	Secret    string   `db:"secret"`
+	IsEnabled bool     `db:"is_enabled"`
+	Failures  uint32   `db:"failures"`
+}
+
+// Accepts is the method for checking whether the subscription is interested in the event.
+func (w Webhook) Accepts(event string) bool {
+	if len(w.Events) == 0 {
+		return true
+	}
+
+	for _, e := range w.Events {
+		if e == event {
+			return true
+		}
+	}
+
+	return false
+}
+
+// String is the method for converting Webhook struct to string representation.
+// The secret is never printed.
+func (w Webhook) String() string {
+	return fmt.Sprintf("{Id: %d, URL: %s, Events: %v, IsEnabled: %t, Failures: %d}",
+		w.Id, w.URL, w.Events, w.IsEnabled, w.Failures)
+}
+
+// WebhookDelivery is the representation of the single webhook delivery log record.
+type WebhookDelivery struct {
+	Id          uint64    `db:"id"`
+	WebhookId   uint64    `db:"webhook_id"`
+	Event       string    `db:"event"`
+	TeamId      uint64    `db:"team_id"`
+	Success     bool      `db:"success"`
+	Attempts    uint32    `db:"attempts"`
+	StatusCode  int       `db:"status_code"`
+	Error       string    `db:"error"`
+	DeliveredAt time.Time `db:"delivered_at"`
+}
@@ -0,0 +1,263 @@
+package operation
+
+import (
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/ozoncp/ocp-team-api/internal/models"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// ErrInvalidPageToken is returned by List when the page token is malformed.
+var ErrInvalidPageToken = errors.New("invalid page token")
+
+// ErrTimeout is the error of the operation that stayed pending longer than the timeout.
+var ErrTimeout = errors.New("operation timed out")
+
+// State is the state of the operation.
+type State uint8
+
+const (
+	// Pending means some teams of the operation are not flushed yet.
+	Pending State = iota
+	// Done means all teams of the operation are created.
+	Done
+	// Failed means some teams of the operation can never be created.
+	Failed
+)
+
+// String is the method for converting State to string representation.
+func (s State) String() string {
+	switch s {
+	case Pending:
+		return "Pending"
+	case Done:
+		return "Done"
+	case Failed:
+		return "Failed"
+	default:
+		return fmt.Sprintf("State(%d)", uint8(s))
+	}
+}
+
+// Operation is the struct representing asynchronous creation of teams.
+// Ids are in the order of the request, zero id means the team is not created.
+// Err is the first error the operation failed with.
+type Operation struct {
+	Id        string
+	State     State
+	Total     int
+	Created   int
+	Failed    int
+	Ids       []uint64
+	Err       error
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	seq      uint64
+	reported []bool
+}
+
+// Name is the method returning the name of the operation in google.longrunning format.
+func (o Operation) Name() string {
+	return "operations/" + o.Id
+}
+
+// Done is the method for checking whether the operation is finished.
+func (o Operation) Done() bool {
+	return o.State != Pending
+}
+
+// Registry is the interface for tracking asynchronous operations.
+// Operations are kept in memory, so they do not survive restarts.
+type Registry interface {
+	Start(total int) Operation
+	Complete(ref models.OperationRef, id uint64)
+	Fail(ref models.OperationRef, err error)
+	Get(id string) (Operation, bool)
+	List(done *bool, pageToken string, pageSize int) ([]Operation, string, error)
+}
+
+// registry is the struct that implements Registry interface.
+type registry struct {
+	retention time.Duration
+	timeout   time.Duration
+	now       func() time.Time
+
+	mu         sync.Mutex
+	seq        uint64
+	operations map[string]*Operation
+}
+
+// NewRegistry is the constructor method for registry struct.
+// Finished operations are forgotten after retention, operations
+// pending longer than timeout are failed with ErrTimeout.
+// Zero duration disables the corresponding limit.
+func NewRegistry(retention, timeout time.Duration) *registry {
+	return &registry{
+		retention:  retention,
+		timeout:    timeout,
+		now:        time.Now,
+		operations: make(map[string]*Operation),
+	}
+}
+
+// Start is the method for registering new pending operation of total teams.
+func (r *registry) Start(total int) Operation {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.expire()
+
+	now := r.now()
+	r.seq++
+	op := &Operation{
+		Id:        uuid.New().String(),
+		State:     Pending,
+		Total:     total,
+		Ids:       make([]uint64, total),
+		CreatedAt: now,
+		UpdatedAt: now,
+		seq:       r.seq,
+		reported:  make([]bool, total),
+	}
+	r.operations[op.Id] = op
+
+	return op.copy()
+}
+
+// Complete is the method for reporting the team of the operation as created.
+// Reports for unknown or finished operations and for already reported teams are ignored.
+func (r *registry) Complete(ref models.OperationRef, id uint64) {
+	r.update(ref, func(op *Operation) {
+		op.Ids[ref.Index] = id
+		op.Created++
+	})
+}
+
+// Fail is the method for reporting the team of the operation as permanently failed.
+// Reports for unknown or finished operations and for already reported teams are ignored.
+func (r *registry) Fail(ref models.OperationRef, err error) {
+	r.update(ref, func(op *Operation) {
+		op.Failed++
+		if op.Err == nil {
+			op.Err = err
+		}
+	})
+}
+
+func (r *registry) update(ref models.OperationRef, apply func(op *Operation)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	op, ok := r.operations[ref.Id]
+	if !ok || op.Done() || ref.Index < 0 || ref.Index >= op.Total || op.reported[ref.Index] {
+		return
+	}
+
+	apply(op)
+	op.reported[ref.Index] = true
+	op.UpdatedAt = r.now()
+
+	if op.Created+op.Failed >= op.Total {
+		if op.Failed == 0 {
+			op.State = Done
+		} else {
+			op.State = Failed
+		}
+	}
+}
+
+// Get is the method for fetching the operation by id.
+func (r *registry) Get(id string) (Operation, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.expire()
+
+	op, ok := r.operations[id]
+	if !ok {
+		return Operation{}, false
+	}
+
+	return op.copy(), true
+}
+
+// List is the method for fetching operations from the newest to the oldest.
+// Nil done matches all operations. It returns the token of the next page,
+// which is empty on the last page. Zero pageSize means no limit.
+func (r *registry) List(done *bool, pageToken string, pageSize int) ([]Operation, string, error) {
+	var after uint64
+	if pageToken != "" {
+		seq, err := strconv.ParseUint(pageToken, 10, 64)
+		if err != nil {
+			return nil, "", ErrInvalidPageToken
+		}
+		after = seq
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.expire()
+
+	matched := make([]*Operation, 0, len(r.operations))
+	for _, op := range r.operations {
+		if after != 0 && op.seq >= after {
+			continue
+		}
+		if done != nil && op.Done() != *done {
+			continue
+		}
+		matched = append(matched, op)
+	}
+
+	sort.Slice(matched, func(i, j int) bool {
+		return matched[i].seq > matched[j].seq
+	})
+
+	var next string
+	if pageSize > 0 && len(matched) > pageSize {
+		matched = matched[:pageSize]
+		next = strconv.FormatUint(matched[pageSize-1].seq, 10)
+	}
+
+	operations := make([]Operation, 0, len(matched))
+	for _, op := range matched {
+		operations = append(operations, op.copy())
+	}
+
+	return operations, next, nil
+}
+
+// expire is the method that fails timed out operations and forgets
+// the ones finished longer than retention ago. Caller must hold the lock.
+func (r *registry) expire() {
+	now := r.now()
+
+	for id, op := range r.operations {
+		if !op.Done() && r.timeout > 0 && now.Sub(op.CreatedAt) > r.timeout {
+			op.State = Failed
+			op.Failed = op.Total - op.Created
+			if op.Err == nil {
+				op.Err = ErrTimeout
+			}
+			op.UpdatedAt = now
+		}
+
+		if op.Done() && r.retention > 0 && now.Sub(op.UpdatedAt) > r.retention {
+			delete(r.operations, id)
+		}
+	}
+}
+
+func (o *Operation) copy() Operation {
+	c := *o
+	c.Ids = append([]uint64(nil), o.Ids...)
+	c.reported = nil
+
+	return c
+}
@@ -0,0 +1,128 @@
+package operation_test
+
+import (
+	"errors"
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/gomega"
+	"github.com/ozoncp/ocp-team-api/internal/models"
+	"github.com/ozoncp/ocp-team-api/internal/operation"
+	"time"
+)
+
+var _ = Describe("Operation", func() {
+
+	var registry operation.Registry
+
+	BeforeEach(func() {
+		registry = operation.NewRegistry(0, 0)
+	})
+
+	Context("registry", func() {
+		It("starts pending operation", func() {
+			op := registry.Start(2)
+
+			gomega.Expect(op.State).Should(gomega.Equal(operation.Pending))
+			gomega.Expect(op.Name()).Should(gomega.Equal("operations/" + op.Id))
+			gomega.Expect(op.Ids).Should(gomega.Equal([]uint64{0, 0}))
+		})
+
+		It("is done when all teams are created", func() {
+			op := registry.Start(2)
+			registry.Complete(models.OperationRef{Id: op.Id, Index: 1}, 20)
+
+			op, _ = registry.Get(op.Id)
+			gomega.Expect(op.State).Should(gomega.Equal(operation.Pending))
+
+			registry.Complete(models.OperationRef{Id: op.Id, Index: 0}, 10)
+
+			op, ok := registry.Get(op.Id)
+			gomega.Expect(ok).Should(gomega.BeTrue())
+			gomega.Expect(op.State).Should(gomega.Equal(operation.Done))
+			gomega.Expect(op.Ids).Should(gomega.Equal([]uint64{10, 20}))
+		})
+
+		It("fails with the first error", func() {
+			op := registry.Start(3)
+			registry.Complete(models.OperationRef{Id: op.Id, Index: 0}, 10)
+			registry.Fail(models.OperationRef{Id: op.Id, Index: 1}, errors.New("first"))
+			registry.Fail(models.OperationRef{Id: op.Id, Index: 2}, errors.New("second"))
+
+			op, _ = registry.Get(op.Id)
+			gomega.Expect(op.State).Should(gomega.Equal(operation.Failed))
+			gomega.Expect(op.Err).Should(gomega.MatchError("first"))
+			gomega.Expect(op.Ids).Should(gomega.Equal([]uint64{10, 0, 0}))
+		})
+
+		It("ignores repeated reports of the team", func() {
+			op := registry.Start(2)
+			ref := models.OperationRef{Id: op.Id, Index: 0}
+			registry.Complete(ref, 10)
+			registry.Complete(ref, 11)
+			registry.Fail(ref, errors.New("invalid"))
+
+			op, _ = registry.Get(op.Id)
+			gomega.Expect(op.State).Should(gomega.Equal(operation.Pending))
+			gomega.Expect(op.Created).Should(gomega.Equal(1))
+			gomega.Expect(op.Failed).Should(gomega.Equal(0))
+			gomega.Expect(op.Ids).Should(gomega.Equal([]uint64{10, 0}))
+		})
+
+		It("ignores reports of unknown operations", func() {
+			registry.Complete(models.OperationRef{Id: "unknown"}, 1)
+
+			_, ok := registry.Get("unknown")
+			gomega.Expect(ok).Should(gomega.BeFalse())
+		})
+
+		It("fails operations pending longer than timeout", func() {
+			registry = operation.NewRegistry(0, time.Millisecond)
+			op := registry.Start(1)
+			time.Sleep(5 * time.Millisecond)
+
+			op, _ = registry.Get(op.Id)
+			gomega.Expect(op.State).Should(gomega.Equal(operation.Failed))
+			gomega.Expect(op.Err).Should(gomega.Equal(operation.ErrTimeout))
+		})
+
+		It("forgets finished operations after retention", func() {
+			registry = operation.NewRegistry(time.Millisecond, 0)
+			op := registry.Start(1)
+			registry.Complete(models.OperationRef{Id: op.Id}, 1)
+			time.Sleep(5 * time.Millisecond)
+
+			_, ok := registry.Get(op.Id)
+			gomega.Expect(ok).Should(gomega.BeFalse())
+		})
+
+		It("lists operations page by page from the newest", func() {
+			first := registry.Start(1)
+			second := registry.Start(1)
+			third := registry.Start(1)
+			registry.Complete(models.OperationRef{Id: second.Id}, 2)
+
+			page, token, err := registry.List(nil, "", 2)
+			gomega.Expect(err).Should(gomega.BeNil())
+			gomega.Expect(page).Should(gomega.HaveLen(2))
+			gomega.Expect(page[0].Id).Should(gomega.Equal(third.Id))
+			gomega.Expect(page[1].Id).Should(gomega.Equal(second.Id))
+			gomega.Expect(token).ShouldNot(gomega.BeEmpty())
+
+			page, token, err = registry.List(nil, token, 2)
+			gomega.Expect(err).Should(gomega.BeNil())
+			gomega.Expect(page).Should(gomega.HaveLen(1))
+			gomega.Expect(page[0].Id).Should(gomega.Equal(first.Id))
+			gomega.Expect(token).Should(gomega.BeEmpty())
+
+			done := true
+			page, _, err = registry.List(&done, "", 0)
+			gomega.Expect(err).Should(gomega.BeNil())
+			gomega.Expect(page).Should(gomega.HaveLen(1))
+			gomega.Expect(page[0].Id).Should(gomega.Equal(second.Id))
+		})
+
+		It("returns error on malformed page token", func() {
+			_, _, err := registry.List(nil, "token", 1)
+			gomega.Expect(err).Should(gomega.Equal(operation.ErrInvalidPageToken))
+		})
+	})
+})
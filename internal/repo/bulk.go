@@ -0,0 +1,151 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/stdlib"
+	"github.com/ozoncp/ocp-team-api/internal/models"
+	"github.com/ozoncp/ocp-team-api/internal/utils"
+	"sort"
+)
+
+// bulkFallbackBatchSize is the number of teams inserted by the single INSERT
+// when COPY cannot be used, it keeps the query below the parameters limit.
+const bulkFallbackBatchSize = 1000
+
+// BulkCreateTeams is the method for creating large number of teams through COPY.
+// Ids are taken from the sequence of team first and assigned to teams in their order,
+// then teams are copied with their ids into the temporary staging table and moved
+// to team with INSERT ... SELECT in the single transaction, so either all teams
+// are created or none. It returns ids in the order of teams. External ids are ignored
+// as by CreateTeams, they are set by UpsertTeams only.
+// In the transaction of WithTx COPY is not available and teams are inserted by batches.
+func (r *repo) BulkCreateTeams(ctx context.Context, teams []models.Team) ([]uint64, error) {
+	if len(teams) == 0 {
+		return []uint64{}, nil
+	}
+
+	if r.inTx {
+		ids := make([]uint64, 0, len(teams))
+		for _, batch := range utils.SplitToBulks(teams, bulkFallbackBatchSize) {
+			batchIds, err := r.CreateTeams(ctx, batch)
+			if err != nil {
+				return nil, err
+			}
+			ids = append(ids, batchIds...)
+		}
+		return ids, nil
+	}
+
+	conn, err := r.db.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	var ids []uint64
+	err = conn.Raw(func(driverConn interface{}) error {
+		stdlibConn, ok := driverConn.(*stdlib.Conn)
+		if !ok {
+			return errors.New("bulk create requires pgx driver")
+		}
+
+		ids, err = copyIn(ctx, stdlibConn.Conn(), teams)
+		return err
+	})
+
+	return ids, err
+}
+
+// copyIn is the method that copies teams with ids assigned by nextIds through the staging table.
+// Statements run on the pgx connection are traced by traceStatement.
+func copyIn(ctx context.Context, conn *pgx.Conn, teams []models.Team) ([]uint64, error) {
+	tx, err := conn.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	ids, err := nextIds(ctx, tx, len(teams))
+	if err != nil {
+		return nil, err
+	}
+
+	if err = execTraced(ctx, tx, `CREATE TEMPORARY TABLE team_staging (
+		id BIGINT NOT NULL,
+		name VARCHAR(100) NOT NULL,
+		description TEXT NOT NULL
+	) ON COMMIT DROP`); err != nil {
+		return nil, err
+	}
+
+	err = traceStatement(ctx, "COPY team_staging (id, name, description) FROM STDIN", func() error {
+		_, err := tx.CopyFrom(
+			ctx,
+			pgx.Identifier{"team_staging"},
+			[]string{"id", "name", "description"},
+			pgx.CopyFromSlice(len(teams), func(i int) ([]interface{}, error) {
+				return []interface{}{int64(ids[i]), teams[i].Name, teams[i].Description}, nil
+			}),
+		)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = execTraced(ctx, tx, `INSERT INTO team (id, name, description)
+		SELECT id, name, description FROM team_staging ORDER BY id`); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+// execTraced is the method running the statement without arguments in the transaction of COPY.
+func execTraced(ctx context.Context, tx pgx.Tx, query string) error {
+	return traceStatement(ctx, query, func() error {
+		_, err := tx.Exec(ctx, query)
+		return err
+	})
+}
+
+// nextIds is the method that takes n values from the sequence of team ids.
+// The values are sorted, so the ids grow in the order of teams as with INSERT.
+func nextIds(ctx context.Context, tx pgx.Tx, n int) ([]uint64, error) {
+	query := `SELECT nextval(pg_get_serial_sequence('team', 'id')) FROM generate_series(1, $1)`
+
+	ids := make([]uint64, 0, n)
+	err := traceStatement(ctx, query, func() error {
+		rows, err := tx.Query(ctx, query, n)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			var id int64
+			if err = rows.Scan(&id); err != nil {
+				return err
+			}
+			ids = append(ids, uint64(id))
+		}
+		return rows.Err()
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(ids) != n {
+		return nil, fmt.Errorf("sequence returned %d ids for %d teams", len(ids), n)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	return ids, nil
+}
@@ -0,0 +1,336 @@
+package repo
+
+import (
+	"container/list"
+	"context"
+	"fmt"
+	"github.com/ozoncp/ocp-team-api/internal/metrics"
+	"github.com/ozoncp/ocp-team-api/internal/models"
+	"sync"
+	"time"
+)
+
+const (
+	cacheKindTeam = "team"
+	cacheKindList = "list"
+)
+
+// CachingRepo is the interface of Repo caching lookups of the next repo.
+type CachingRepo interface {
+	Repo
+	Invalidate(teamId uint64)
+	Purge()
+}
+
+// cacheEntry is the cached result of GetTeam or ListTeams.
+type cacheEntry struct {
+	key     string
+	kind    string
+	team    models.Team
+	teams   []models.Team
+	total   uint64
+	expires time.Time
+}
+
+// cachingRepo is the struct that implements CachingRepo interface caching GetTeam
+// and ListTeams pages of the next repo in the bounded LRU with TTL.
+// Writes made through the repo invalidate the entries they affect, writes made
+// elsewhere are dropped with Invalidate or expire after TTL. Misses are read
+// through the routing of the next repo, so the lag of replicas is cached for TTL
+// at most, unless WithPrimaryFill is set.
+type cachingRepo struct {
+	next        Repo
+	size        int
+	ttl         time.Duration
+	primaryFill bool
+
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	lru     *list.List
+	// generation is incremented by every invalidation, so results read
+	// before the invalidation are not put in the cache after it.
+	generation uint64
+}
+
+// CacheOption is the type of optional cache settings passed to NewCachingRepo.
+type CacheOption func(r *cachingRepo)
+
+// WithPrimaryFill is the option reading misses with WithPrimary context,
+// so a lagging replica never fills the cache at the cost of the primary load.
+func WithPrimaryFill() CacheOption {
+	return func(r *cachingRepo) {
+		r.primaryFill = true
+	}
+}
+
+// NewCachingRepo is the constructor method for cachingRepo struct.
+// It caches at most size entries for ttl each.
+// It returns nil if size or ttl is not positive.
+func NewCachingRepo(next Repo, size int, ttl time.Duration, opts ...CacheOption) *cachingRepo {
+	if size <= 0 || ttl <= 0 {
+		return nil
+	}
+
+	r := &cachingRepo{
+		next:    next,
+		size:    size,
+		ttl:     ttl,
+		entries: make(map[string]*list.Element, size),
+		lru:     list.New(),
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
+}
+
+func teamKey(teamId uint64) string {
+	return fmt.Sprintf("team:%d", teamId)
+}
+
+func listKey(limit, offset uint64) string {
+	return fmt.Sprintf("list:%d:%d", limit, offset)
+}
+
+// fillContext is the method returning the context of reads filling misses.
+func (r *cachingRepo) fillContext(ctx context.Context) context.Context {
+	if r.primaryFill {
+		return WithPrimary(ctx)
+	}
+	return ctx
+}
+
+// lookup is the method returning the entry by key if it is not expired
+// together with the current generation.
+func (r *cachingRepo) lookup(key, kind string) (*cacheEntry, uint64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if elem, ok := r.entries[key]; ok {
+		entry := elem.Value.(*cacheEntry)
+		if time.Now().Before(entry.expires) {
+			r.lru.MoveToFront(elem)
+			metrics.IncCacheHits(kind)
+			return entry, r.generation
+		}
+		r.remove(elem)
+	}
+
+	metrics.IncCacheMisses(kind)
+	return nil, r.generation
+}
+
+// store is the method putting the entry in the cache unless
+// the cache was invalidated after generation.
+func (r *cachingRepo) store(entry *cacheEntry, generation uint64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if generation != r.generation {
+		return
+	}
+
+	entry.expires = time.Now().Add(r.ttl)
+	if elem, ok := r.entries[entry.key]; ok {
+		elem.Value = entry
+		r.lru.MoveToFront(elem)
+		return
+	}
+
+	r.entries[entry.key] = r.lru.PushFront(entry)
+	for r.lru.Len() > r.size {
+		r.remove(r.lru.Back())
+	}
+}
+
+func (r *cachingRepo) remove(elem *list.Element) {
+	r.lru.Remove(elem)
+	delete(r.entries, elem.Value.(*cacheEntry).key)
+}
+
+// Invalidate is the method dropping the cached team and all cached pages,
+// which may contain it. It is called for the teams changed by other instances.
+func (r *cachingRepo) Invalidate(teamId uint64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.generation++
+	if elem, ok := r.entries[teamKey(teamId)]; ok {
+		r.remove(elem)
+	}
+	r.invalidatePages()
+}
+
+// Purge is the method dropping all cached entries.
+func (r *cachingRepo) Purge() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.generation++
+	r.entries = make(map[string]*list.Element, r.size)
+	r.lru.Init()
+}
+
+// invalidateTeams is the method dropping the cached teams and pages
+// after they are changed through the repo.
+func (r *cachingRepo) invalidateTeams(teamIds ...uint64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.generation++
+	for _, teamId := range teamIds {
+		if elem, ok := r.entries[teamKey(teamId)]; ok {
+			r.remove(elem)
+		}
+	}
+	r.invalidatePages()
+}
+
+// invalidatePages is the method dropping all cached pages, it must be called under the lock.
+func (r *cachingRepo) invalidatePages() {
+	for elem := r.lru.Front(); elem != nil; {
+		next := elem.Next()
+		if elem.Value.(*cacheEntry).kind == cacheKindList {
+			r.remove(elem)
+		}
+		elem = next
+	}
+}
+
+// CreateTeam is the method creating the team in the next repo and dropping cached pages.
+func (r *cachingRepo) CreateTeam(ctx context.Context, team *models.Team) error {
+	err := r.next.CreateTeam(ctx, team)
+	r.invalidateTeams()
+	return err
+}
+
+// CreateTeams is the method creating the teams in the next repo and dropping cached pages.
+func (r *cachingRepo) CreateTeams(ctx context.Context, teams []models.Team) ([]uint64, error) {
+	ids, err := r.next.CreateTeams(ctx, teams)
+	r.invalidateTeams()
+	return ids, err
+}
+
+// BulkCreateTeams is the method creating the teams in the next repo and dropping cached pages.
+func (r *cachingRepo) BulkCreateTeams(ctx context.Context, teams []models.Team) ([]uint64, error) {
+	ids, err := r.next.BulkCreateTeams(ctx, teams)
+	r.invalidateTeams()
+	return ids, err
+}
+
+// UpsertTeams is the method upserting the teams in the next repo and dropping
+// the cached teams and pages.
+func (r *cachingRepo) UpsertTeams(ctx context.Context, teams []models.Team) ([]UpsertResult, error) {
+	results, err := r.next.UpsertTeams(ctx, teams)
+	if err != nil {
+		r.Purge()
+		return nil, err
+	}
+
+	ids := make([]uint64, 0, len(results))
+	for _, result := range results {
+		if result.Status != Unchanged {
+			ids = append(ids, result.Id)
+		}
+	}
+	if len(ids) > 0 {
+		r.invalidateTeams(ids...)
+	}
+
+	return results, nil
+}
+
+// GetTeam is the method returning the cached team or fetching it from the next repo.
+// Errors including sql.ErrNoRows are not cached.
+func (r *cachingRepo) GetTeam(ctx context.Context, teamId uint64) (*models.Team, error) {
+	key := teamKey(teamId)
+
+	entry, generation := r.lookup(key, cacheKindTeam)
+	if entry != nil {
+		team := entry.team
+		return &team, nil
+	}
+
+	team, err := r.next.GetTeam(r.fillContext(ctx), teamId)
+	if err != nil {
+		return nil, err
+	}
+
+	r.store(&cacheEntry{key: key, kind: cacheKindTeam, team: *team}, generation)
+
+	return team, nil
+}
+
+// CountTeams is the method counting the teams in the next repo, it is not cached.
+func (r *cachingRepo) CountTeams(ctx context.Context) (uint64, error) {
+	return r.next.CountTeams(ctx)
+}
+
+// ListTeams is the method returning the cached page of teams or fetching it from the next repo.
+func (r *cachingRepo) ListTeams(ctx context.Context, limit, offset uint64) ([]models.Team, uint64, error) {
+	key := listKey(limit, offset)
+
+	entry, generation := r.lookup(key, cacheKindList)
+	if entry != nil {
+		return copyTeams(entry.teams), entry.total, nil
+	}
+
+	teams, total, err := r.next.ListTeams(r.fillContext(ctx), limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	r.store(&cacheEntry{key: key, kind: cacheKindList, teams: copyTeams(teams), total: total}, generation)
+
+	return teams, total, nil
+}
+
+// ListTeamsAfter is the method listing the teams in the next repo, it is not cached
+// as it is used for streaming the whole table.
+func (r *cachingRepo) ListTeamsAfter(ctx context.Context, afterId, limit uint64) ([]models.Team, error) {
+	return r.next.ListTeamsAfter(ctx, afterId, limit)
+}
+
+// RemoveTeam is the method removing the team in the next repo and dropping
+// the cached team and pages.
+func (r *cachingRepo) RemoveTeam(ctx context.Context, teamId uint64) error {
+	err := r.next.RemoveTeam(ctx, teamId)
+	r.invalidateTeams(teamId)
+	return err
+}
+
+// UpdateTeam is the method updating the team in the next repo and dropping
+// the cached team and pages.
+func (r *cachingRepo) UpdateTeam(ctx context.Context, team *models.Team) error {
+	err := r.next.UpdateTeam(ctx, team)
+	r.invalidateTeams(team.Id)
+	return err
+}
+
+// SearchTeams is the method searching the teams in the next repo, it is not cached.
+func (r *cachingRepo) SearchTeams(ctx context.Context, query SearchQuery) (*SearchResult, error) {
+	return r.next.SearchTeams(ctx, query)
+}
+
+// SuggestTeams is the method completing the names of teams in the next repo, it is not cached.
+func (r *cachingRepo) SuggestTeams(ctx context.Context, prefix string, limit uint64) ([]string, error) {
+	return r.next.SuggestTeams(ctx, prefix, limit)
+}
+
+// WithTx is the method running fn in the transaction of the next repo.
+// Reads in the transaction bypass the cache, which is purged after the transaction.
+func (r *cachingRepo) WithTx(ctx context.Context, fn func(tx Repo) error, opts ...TxOption) error {
+	defer r.Purge()
+	return r.next.WithTx(ctx, fn, opts...)
+}
+
+func copyTeams(teams []models.Team) []models.Team {
+	if teams == nil {
+		return nil
+	}
+
+	copied := make([]models.Team, len(teams))
+	copy(copied, teams)
+	return copied
+}
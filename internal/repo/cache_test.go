@@ -0,0 +1,196 @@
+package repo_test
+
+import (
+	"context"
+	"database/sql"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/ozoncp/ocp-team-api/internal/mocks"
+	"github.com/ozoncp/ocp-team-api/internal/models"
+	"github.com/ozoncp/ocp-team-api/internal/repo"
+	"time"
+)
+
+var _ = Describe("CachingRepo", func() {
+
+	var (
+		ctrl     *gomock.Controller
+		ctx      context.Context
+		mockRepo *mocks.MockRepo
+		cache    repo.CachingRepo
+	)
+
+	team := &models.Team{Id: 1, Name: "Name"}
+	teams := []models.Team{{Id: 1, Name: "Name"}, {Id: 2, Name: "Other"}}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		ctx = context.Background()
+		mockRepo = mocks.NewMockRepo(ctrl)
+		cache = repo.NewCachingRepo(mockRepo, 2, time.Minute)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("returns nil for invalid settings", func() {
+		Expect(repo.NewCachingRepo(mockRepo, 0, time.Minute)).Should(BeNil())
+		Expect(repo.NewCachingRepo(mockRepo, 1, 0)).Should(BeNil())
+	})
+
+	It("serves repeated lookups from the cache", func() {
+		mockRepo.EXPECT().GetTeam(ctx, uint64(1)).Return(&models.Team{Id: 1, Name: "Name"}, nil).Times(1)
+		mockRepo.EXPECT().ListTeams(ctx, uint64(2), uint64(0)).Return(teams, uint64(2), nil).Times(1)
+
+		for i := 0; i < 2; i++ {
+			result, err := cache.GetTeam(ctx, 1)
+			Expect(err).Should(BeNil())
+			Expect(result).Should(Equal(team))
+			result.Name = "Changed"
+
+			page, total, err := cache.ListTeams(ctx, 2, 0)
+			Expect(err).Should(BeNil())
+			Expect(total).Should(Equal(uint64(2)))
+			Expect(page).Should(Equal(teams))
+		}
+	})
+
+	It("does not cache errors", func() {
+		mockRepo.EXPECT().GetTeam(ctx, uint64(1)).Return(nil, sql.ErrNoRows).Times(2)
+
+		for i := 0; i < 2; i++ {
+			_, err := cache.GetTeam(ctx, 1)
+			Expect(err).Should(MatchError(sql.ErrNoRows))
+		}
+	})
+
+	It("expires entries after ttl", func() {
+		cache = repo.NewCachingRepo(mockRepo, 2, 10*time.Millisecond)
+		mockRepo.EXPECT().GetTeam(ctx, uint64(1)).Return(team, nil).Times(2)
+
+		_, _ = cache.GetTeam(ctx, 1)
+		time.Sleep(20 * time.Millisecond)
+		_, _ = cache.GetTeam(ctx, 1)
+	})
+
+	It("evicts the least recently used entry", func() {
+		mockRepo.EXPECT().GetTeam(ctx, uint64(1)).Return(team, nil).Times(1)
+		mockRepo.EXPECT().GetTeam(ctx, uint64(2)).Return(&teams[1], nil).Times(2)
+		mockRepo.EXPECT().GetTeam(ctx, uint64(3)).Return(&models.Team{Id: 3}, nil).Times(1)
+
+		_, _ = cache.GetTeam(ctx, 1)
+		_, _ = cache.GetTeam(ctx, 2)
+		_, _ = cache.GetTeam(ctx, 1)
+		_, _ = cache.GetTeam(ctx, 3)
+		_, _ = cache.GetTeam(ctx, 1)
+		_, _ = cache.GetTeam(ctx, 2)
+	})
+
+	It("drops the team and pages on local writes", func() {
+		updated := &models.Team{Id: 1, Name: "Updated"}
+		gomock.InOrder(
+			mockRepo.EXPECT().GetTeam(ctx, uint64(1)).Return(team, nil),
+			mockRepo.EXPECT().UpdateTeam(ctx, updated).Return(nil),
+			mockRepo.EXPECT().GetTeam(ctx, uint64(1)).Return(updated, nil),
+		)
+		gomock.InOrder(
+			mockRepo.EXPECT().ListTeams(ctx, uint64(2), uint64(0)).Return(teams, uint64(2), nil),
+			mockRepo.EXPECT().CreateTeams(ctx, gomock.Any()).Return([]uint64{3}, nil),
+			mockRepo.EXPECT().ListTeams(ctx, uint64(2), uint64(0)).Return(teams, uint64(3), nil),
+		)
+
+		_, _ = cache.GetTeam(ctx, 1)
+		Expect(cache.UpdateTeam(ctx, updated)).Should(Succeed())
+		Expect(cache.GetTeam(ctx, 1)).Should(Equal(updated))
+
+		_, _, _ = cache.ListTeams(ctx, 2, 0)
+		_, _ = cache.CreateTeams(ctx, []models.Team{{Name: "New"}})
+		_, total, _ := cache.ListTeams(ctx, 2, 0)
+		Expect(total).Should(Equal(uint64(3)))
+	})
+
+	It("drops the team and pages on invalidation", func() {
+		mockRepo.EXPECT().GetTeam(ctx, uint64(1)).Return(team, nil).Times(2)
+		mockRepo.EXPECT().GetTeam(ctx, uint64(2)).Return(&teams[1], nil).Times(1)
+		mockRepo.EXPECT().ListTeams(ctx, uint64(2), uint64(0)).Return(teams, uint64(2), nil).Times(2)
+
+		_, _ = cache.GetTeam(ctx, 1)
+		_, _ = cache.GetTeam(ctx, 2)
+		_, _, _ = cache.ListTeams(ctx, 2, 0)
+
+		cache.Invalidate(1)
+
+		_, _ = cache.GetTeam(ctx, 1)
+		_, _ = cache.GetTeam(ctx, 2)
+		_, _, _ = cache.ListTeams(ctx, 2, 0)
+	})
+
+	It("does not cache results read before invalidation", func() {
+		mockRepo.EXPECT().GetTeam(ctx, uint64(1)).DoAndReturn(func(context.Context, uint64) (*models.Team, error) {
+			cache.Invalidate(1)
+			return team, nil
+		}).Times(1)
+		mockRepo.EXPECT().GetTeam(ctx, uint64(1)).Return(team, nil).Times(1)
+
+		_, _ = cache.GetTeam(ctx, 1)
+		_, _ = cache.GetTeam(ctx, 1)
+		_, _ = cache.GetTeam(ctx, 1)
+	})
+
+	It("fills misses through the replica routing", func() {
+		mockReplica := mocks.NewMockRepo(ctrl)
+		mockRepo.EXPECT().GetTeam(gomock.Any(), gomock.Any()).Times(0)
+		mockReplica.EXPECT().GetTeam(gomock.Any(), uint64(1)).Return(team, nil).Times(1)
+		mockReplica.EXPECT().ListTeams(gomock.Any(), uint64(2), uint64(0)).Return(teams, uint64(2), nil).Times(1)
+
+		cache = repo.NewCachingRepo(
+			repo.NewRoutingRepo(mockRepo, []repo.Replica{{Name: "replica", Repo: mockReplica}}),
+			2,
+			time.Minute,
+		)
+
+		Expect(cache.GetTeam(ctx, 1)).Should(Equal(team))
+		_, total, err := cache.ListTeams(ctx, 2, 0)
+		Expect(err).Should(BeNil())
+		Expect(total).Should(Equal(uint64(2)))
+	})
+
+	It("fills misses of the session that wrote from the primary", func() {
+		mockReplica := mocks.NewMockRepo(ctrl)
+		mockReplica.EXPECT().GetTeam(gomock.Any(), gomock.Any()).Times(0)
+		mockRepo.EXPECT().RemoveTeam(gomock.Any(), uint64(2)).Return(nil)
+		mockRepo.EXPECT().GetTeam(gomock.Any(), uint64(1)).Return(team, nil).Times(1)
+
+		cache = repo.NewCachingRepo(
+			repo.NewRoutingRepo(mockRepo, []repo.Replica{{Name: "replica", Repo: mockReplica}}, repo.WithReadYourWrites(time.Hour)),
+			2,
+			time.Minute,
+		)
+
+		session := repo.WithSession(ctx, "session")
+		Expect(cache.RemoveTeam(session, 2)).Should(Succeed())
+		Expect(cache.GetTeam(session, 1)).Should(Equal(team))
+	})
+
+	It("fills misses from the primary with primary fill", func() {
+		mockReplica := mocks.NewMockRepo(ctrl)
+		mockReplica.EXPECT().GetTeam(gomock.Any(), gomock.Any()).Times(0)
+		mockReplica.EXPECT().ListTeams(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+		mockRepo.EXPECT().GetTeam(gomock.Any(), uint64(1)).Return(team, nil).Times(1)
+		mockRepo.EXPECT().ListTeams(gomock.Any(), uint64(2), uint64(0)).Return(teams, uint64(2), nil).Times(1)
+
+		cache = repo.NewCachingRepo(
+			repo.NewRoutingRepo(mockRepo, []repo.Replica{{Name: "replica", Repo: mockReplica}}),
+			2,
+			time.Minute,
+			repo.WithPrimaryFill(),
+		)
+
+		Expect(cache.GetTeam(ctx, 1)).Should(Equal(team))
+		_, total, err := cache.ListTeams(ctx, 2, 0)
+		Expect(err).Should(BeNil())
+		Expect(total).Should(Equal(uint64(2)))
+	})
+})
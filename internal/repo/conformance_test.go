@@ -0,0 +1,316 @@
+package repo_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/ozoncp/ocp-team-api/internal/models"
+	"github.com/ozoncp/ocp-team-api/internal/repo"
+	"github.com/ozoncp/ocp-team-api/internal/utils"
+	"sync"
+)
+
+// repoConformance is the spec every storage backend of Repo must pass.
+// getRepo returns the empty repo made by BeforeEach of the calling container.
+func repoConformance(getRepo func() repo.Repo) {
+
+	var (
+		ctx context.Context
+		r   repo.Repo
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		r = getRepo()
+	})
+
+	create := func(teams ...models.Team) []uint64 {
+		ids, err := r.CreateTeams(ctx, teams)
+		Expect(err).Should(BeNil())
+		return ids
+	}
+
+	ids := func(hits []repo.SearchHit) []uint64 {
+		found := make([]uint64, 0, len(hits))
+		for _, hit := range hits {
+			found = append(found, hit.Team.Id)
+		}
+		return found
+	}
+
+	It("creates teams with growing ids", func() {
+		team := models.Team{Name: "First"}
+		Expect(r.CreateTeam(ctx, &team)).Should(Succeed())
+		Expect(team.Id).Should(Equal(uint64(1)))
+
+		Expect(create(models.Team{Name: "Second"}, models.Team{Name: "Third"})).Should(Equal([]uint64{2, 3}))
+	})
+
+	It("creates teams without external ids", func() {
+		created, err := r.BulkCreateTeams(ctx, []models.Team{{Name: "First", ExternalId: "ext-1"}})
+		Expect(err).Should(BeNil())
+		created = append(created, create(models.Team{Name: "Second", ExternalId: "ext-2"})...)
+
+		for _, id := range created {
+			team, err := r.GetTeam(ctx, id)
+			Expect(err).Should(BeNil())
+			Expect(team.ExternalId).Should(BeEmpty())
+		}
+
+		results, err := r.UpsertTeams(ctx, []models.Team{{Name: "First", ExternalId: "ext-1"}})
+		Expect(err).Should(BeNil())
+		Expect(results[0].Status).Should(Equal(repo.Created))
+	})
+
+	It("soft deletes teams", func() {
+		created := create(models.Team{Name: "First"}, models.Team{Name: "Second"})
+		Expect(r.RemoveTeam(ctx, created[0])).Should(Succeed())
+
+		_, err := r.GetTeam(ctx, created[0])
+		Expect(err).Should(MatchError(sql.ErrNoRows))
+		Expect(r.CountTeams(ctx)).Should(Equal(uint64(1)))
+
+		Expect(r.UpdateTeam(ctx, &models.Team{Id: created[0], Name: "Restored"})).Should(Succeed())
+		_, err = r.GetTeam(ctx, created[0])
+		Expect(err).Should(MatchError(sql.ErrNoRows))
+	})
+
+	It("paginates teams that are not removed", func() {
+		created := create(
+			models.Team{Name: "First"},
+			models.Team{Name: "Second"},
+			models.Team{Name: "Third", Description: "Description of Third"},
+			models.Team{Name: "Fourth"},
+		)
+		Expect(r.RemoveTeam(ctx, created[1])).Should(Succeed())
+
+		teams, total, err := r.ListTeams(ctx, 2, 1)
+		Expect(err).Should(BeNil())
+		Expect(total).Should(Equal(uint64(3)))
+		Expect(teams).Should(Equal([]models.Team{
+			{Id: created[2], Name: "Third", Description: "Description of Third"},
+			{Id: created[3], Name: "Fourth"},
+		}))
+
+		teams, err = r.ListTeamsAfter(ctx, created[0], 10)
+		Expect(err).Should(BeNil())
+		Expect(teams).Should(HaveLen(2))
+		Expect(teams[0].Id).Should(Equal(created[2]))
+	})
+
+	It("upserts teams by external id", func() {
+		results, err := r.UpsertTeams(ctx, []models.Team{
+			{ExternalId: "a", Name: "Alpha"},
+			{ExternalId: "b", Name: "Beta"},
+		})
+		Expect(err).Should(BeNil())
+		Expect(results).Should(Equal([]repo.UpsertResult{
+			{Id: 1, ExternalId: "a", Status: repo.Created},
+			{Id: 2, ExternalId: "b", Status: repo.Created},
+		}))
+
+		Expect(r.RemoveTeam(ctx, 2)).Should(Succeed())
+
+		results, err = r.UpsertTeams(ctx, []models.Team{
+			{ExternalId: "a", Name: "Alpha"},
+			{ExternalId: "b", Name: "Beta"},
+			{ExternalId: "a2", Name: "Alpha 2"},
+		})
+		Expect(err).Should(BeNil())
+		Expect(results).Should(Equal([]repo.UpsertResult{
+			{Id: 1, ExternalId: "a", Status: repo.Unchanged},
+			{Id: 2, ExternalId: "b", Status: repo.Created},
+			{Id: 3, ExternalId: "a2", Status: repo.Created},
+		}))
+
+		results, err = r.UpsertTeams(ctx, []models.Team{{ExternalId: "a", Name: "Alpha", Description: "First"}})
+		Expect(err).Should(BeNil())
+		Expect(results).Should(Equal([]repo.UpsertResult{{Id: 1, ExternalId: "a", Status: repo.Updated}}))
+
+		team, err := r.GetTeam(ctx, 1)
+		Expect(err).Should(BeNil())
+		Expect(*team).Should(Equal(models.Team{Id: 1, Name: "Alpha", Description: "First", ExternalId: "a"}))
+	})
+
+	It("updates external id of the team", func() {
+		results, err := r.UpsertTeams(ctx, []models.Team{
+			{ExternalId: "a", Name: "Alpha"},
+			{ExternalId: "b", Name: "Beta"},
+		})
+		Expect(err).Should(BeNil())
+		id := results[0].Id
+
+		Expect(r.UpdateTeam(ctx, &models.Team{Id: id, Name: "Alpha"})).Should(Succeed())
+		team, err := r.GetTeam(ctx, id)
+		Expect(err).Should(BeNil())
+		Expect(team.ExternalId).Should(Equal("a"))
+
+		Expect(r.UpdateTeam(ctx, &models.Team{Id: id, Name: "Alpha", ExternalId: "a2"})).Should(Succeed())
+		team, err = r.GetTeam(ctx, id)
+		Expect(err).Should(BeNil())
+		Expect(team.ExternalId).Should(Equal("a2"))
+
+		err = r.UpdateTeam(ctx, &models.Team{Id: id, Name: "Alpha", ExternalId: "b"})
+		Expect(err).Should(MatchError(repo.ErrExternalIdTaken))
+
+		results, err = r.UpsertTeams(ctx, []models.Team{{ExternalId: "a2", Name: "Alpha"}})
+		Expect(err).Should(BeNil())
+		Expect(results).Should(Equal([]repo.UpsertResult{{Id: id, ExternalId: "a2", Status: repo.Unchanged}}))
+	})
+
+	It("searches words and phrases with highlighting", func() {
+		created := create(
+			models.Team{Name: "Backend", Description: "Go services of the platform"},
+			models.Team{Name: "Platform services", Description: "Infrastructure"},
+			models.Team{Name: "Frontend", Description: "Services of the platform web"},
+		)
+
+		search := func(text string, searchType utils.SearchType) []repo.SearchHit {
+			result, err := r.SearchTeams(ctx, repo.SearchQuery{Text: text, Type: searchType})
+			Expect(err).Should(BeNil())
+			Expect(result.Total).Should(Equal(uint64(len(result.Hits))))
+			Expect(result.NextPageToken).Should(BeEmpty())
+			return result.Hits
+		}
+
+		hits := search("platform services", utils.Plain)
+		Expect(hits).Should(HaveLen(3))
+		Expect(hits[0].Team).Should(Equal(models.Team{
+			Id: created[1], Name: "<b>Platform</b> <b>services</b>", Description: "Infrastructure",
+		}))
+		Expect(hits[0].Score).Should(BeNumerically(">", hits[1].Score))
+
+		Expect(ids(search("services of the platform", utils.Phrase))).Should(ConsistOf(created[0], created[2]))
+
+		Expect(r.RemoveTeam(ctx, created[0])).Should(Succeed())
+		Expect(r.UpdateTeam(ctx, &models.Team{Id: created[2], Name: "Frontend", Description: "Web"})).Should(Succeed())
+
+		Expect(search("services of the platform", utils.Phrase)).Should(BeEmpty())
+		Expect(ids(search("platform", utils.Plain))).Should(Equal([]uint64{created[1]}))
+
+		_, err := r.SearchTeams(ctx, repo.SearchQuery{Text: "platform", Type: utils.SearchType(10)})
+		Expect(err).ShouldNot(BeNil())
+	})
+
+	It("pages search hits", func() {
+		created := create(
+			models.Team{Name: "Core", Description: "First"},
+			models.Team{Name: "Core", Description: "Second"},
+			models.Team{Name: "Core", Description: "Third"},
+			models.Team{Name: "Backend", Description: "Core of the platform with the long description"},
+			models.Team{Name: "Frontend", Description: "Web"},
+		)
+
+		var found []uint64
+		var token string
+		for {
+			result, err := r.SearchTeams(ctx, repo.SearchQuery{Text: "core", Type: utils.Plain, Limit: 2, PageToken: token})
+			Expect(err).Should(BeNil())
+			Expect(result.Total).Should(Equal(uint64(4)))
+			Expect(len(result.Hits)).Should(BeNumerically("<=", 2))
+			found = append(found, ids(result.Hits)...)
+
+			if token = result.NextPageToken; token == "" {
+				break
+			}
+		}
+		Expect(found).Should(Equal(created[:4]))
+
+		all, err := r.SearchTeams(ctx, repo.SearchQuery{Text: "core", Type: utils.Plain})
+		Expect(err).Should(BeNil())
+
+		result, err := r.SearchTeams(ctx, repo.SearchQuery{Text: "core", Type: utils.Plain, MinScore: all.Hits[2].Score})
+		Expect(err).Should(BeNil())
+		Expect(result.Total).Should(Equal(uint64(3)))
+
+		_, err = r.SearchTeams(ctx, repo.SearchQuery{Text: "core", Type: utils.Plain, PageToken: "invalid"})
+		Expect(err).Should(MatchError(repo.ErrInvalidPageToken))
+	})
+
+	It("searches with web search syntax and prefixes", func() {
+		created := create(
+			models.Team{Name: "Core payments", Description: "Billing backend"},
+			models.Team{Name: "Payments web", Description: "Checkout frontend"},
+			models.Team{Name: "Platform", Description: "Core infrastructure"},
+		)
+
+		search := func(text string, searchType utils.SearchType) []uint64 {
+			result, err := r.SearchTeams(ctx, repo.SearchQuery{Text: text, Type: searchType})
+			Expect(err).Should(BeNil())
+			return ids(result.Hits)
+		}
+
+		Expect(search(`payments -web`, utils.Websearch)).Should(Equal([]uint64{created[0]}))
+		Expect(search(`"core payments" OR checkout`, utils.Websearch)).Should(ConsistOf(created[0], created[1]))
+		Expect(search(`"core payments" or checkout`, utils.Websearch)).Should(ConsistOf(created[0], created[1]))
+		Expect(search(`"payments core"`, utils.Websearch)).Should(BeEmpty())
+		Expect(search(`-payments`, utils.Websearch)).Should(BeEmpty())
+
+		Expect(search(`paym back`, utils.Prefix)).Should(Equal([]uint64{created[0]}))
+		Expect(search(`co`, utils.Prefix)).Should(ConsistOf(created[0], created[2]))
+		Expect(search(`*:' NEAR(`, utils.Prefix)).Should(BeEmpty())
+
+		result, err := r.SearchTeams(ctx, repo.SearchQuery{Text: "paym", Type: utils.Prefix})
+		Expect(err).Should(BeNil())
+		Expect(result.Hits[0].Team.Name).Should(Equal("Core <b>payments</b>"))
+	})
+
+	It("suggests names by prefix", func() {
+		create(
+			models.Team{Name: "Payments web"},
+			models.Team{Name: "Payments"},
+			models.Team{Name: "payments"},
+			models.Team{Name: "Payments"},
+			models.Team{Name: "Platform"},
+			models.Team{Name: "100% uptime"},
+		)
+
+		Expect(r.SuggestTeams(ctx, "PAY", 5)).Should(Equal([]string{"Payments", "payments", "Payments web"}))
+		Expect(r.SuggestTeams(ctx, "p", 2)).Should(Equal([]string{"Payments", "Platform"}))
+		Expect(r.SuggestTeams(ctx, "100%", 5)).Should(Equal([]string{"100% uptime"}))
+		Expect(r.SuggestTeams(ctx, "10_", 5)).Should(BeEmpty())
+		Expect(r.SuggestTeams(ctx, "x", 5)).Should(BeEmpty())
+	})
+
+	It("discards changes of failed transaction", func() {
+		errFailed := errors.New("failed")
+
+		err := r.WithTx(ctx, func(tx repo.Repo) error {
+			Expect(tx.CreateTeam(ctx, &models.Team{Name: "First"})).Should(Succeed())
+			_, err := tx.CreateTeams(ctx, []models.Team{{Name: "Second"}})
+			Expect(err).Should(BeNil())
+			return errFailed
+		})
+		Expect(err).Should(MatchError(errFailed))
+		Expect(r.CountTeams(ctx)).Should(BeZero())
+
+		err = r.WithTx(ctx, func(tx repo.Repo) error {
+			return tx.CreateTeam(ctx, &models.Team{Name: "Kept"})
+		})
+		Expect(err).Should(BeNil())
+		Expect(r.CountTeams(ctx)).Should(Equal(uint64(1)))
+	})
+
+	It("is safe for concurrent use", func() {
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer GinkgoRecover()
+				defer wg.Done()
+
+				for j := 0; j < 10; j++ {
+					team := models.Team{Name: "Team"}
+					Expect(r.CreateTeam(ctx, &team)).Should(Succeed())
+					_, err := r.GetTeam(ctx, team.Id)
+					Expect(err).Should(BeNil())
+				}
+			}()
+		}
+		wg.Wait()
+
+		Expect(r.CountTeams(ctx)).Should(Equal(uint64(100)))
+	})
+}
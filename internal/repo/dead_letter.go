@@ -0,0 +1,180 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+	"github.com/ozoncp/ocp-team-api/internal/models"
+	"strings"
+)
+
+const deadLetterTableName = "dead_letter"
+
+// DeadLetterRepo is the interface that wraps storage operations on teams
+// that could not be persisted.
+type DeadLetterRepo interface {
+	AddDeadLetter(ctx context.Context, letter *models.DeadLetter) error
+	GetDeadLetter(ctx context.Context, letterId uint64) (*models.DeadLetter, error)
+	CountDeadLetters(ctx context.Context) (uint64, error)
+	ListDeadLetters(ctx context.Context, limit, offset uint64) ([]models.DeadLetter, uint64, error)
+	UpdateDeadLetter(ctx context.Context, letter *models.DeadLetter) error
+	RemoveDeadLetter(ctx context.Context, letterId uint64) (bool, error)
+}
+
+// NewDeadLetterRepo is the constructor method for deadLetterRepo struct.
+func NewDeadLetterRepo(db *sqlx.DB) *deadLetterRepo {
+	return &deadLetterRepo{db}
+}
+
+// deadLetterRepo is the struct that implements DeadLetterRepo interface through sqlx library.
+type deadLetterRepo struct {
+	db *sqlx.DB
+}
+
+var deadLetterColumns = []string{
+	"id", "COALESCE(team_id, 0)", "COALESCE(external_id, '')", "name", "description", "sinks",
+	"error", "attempts", "created_at", "updated_at",
+}
+
+// AddDeadLetter is the method for storing the team that could not be persisted through SQL INSERT.
+// Id and timestamps of the letter are filled.
+func (r *deadLetterRepo) AddDeadLetter(ctx context.Context, letter *models.DeadLetter) error {
+	query := sq.Insert(deadLetterTableName).
+		Columns("team_id", "external_id", "name", "description", "sinks", "error", "attempts").
+		Values(
+			sql.NullInt64{Int64: int64(letter.Team.Id), Valid: letter.Team.Id != 0},
+			sql.NullString{String: letter.Team.ExternalId, Valid: letter.Team.ExternalId != ""},
+			letter.Team.Name,
+			letter.Team.Description,
+			strings.Join(letter.Sinks, ","),
+			letter.Error,
+			letter.Attempts,
+		).
+		Suffix("RETURNING id, created_at, updated_at").
+		RunWith(r.db).
+		PlaceholderFormat(sq.Dollar)
+
+	return query.QueryRowContext(ctx).Scan(&letter.Id, &letter.CreatedAt, &letter.UpdatedAt)
+}
+
+// GetDeadLetter is the method for fetching the dead letter by id.
+// It returns sql.ErrNoRows if there is no such letter.
+func (r *deadLetterRepo) GetDeadLetter(ctx context.Context, letterId uint64) (*models.DeadLetter, error) {
+	query := sq.Select(deadLetterColumns...).
+		From(deadLetterTableName).
+		Where(sq.Eq{"id": letterId}).
+		RunWith(r.db).
+		PlaceholderFormat(sq.Dollar)
+
+	var letter models.DeadLetter
+	if err := scanDeadLetter(query.QueryRowContext(ctx), &letter); err != nil {
+		return nil, err
+	}
+
+	return &letter, nil
+}
+
+// CountDeadLetters is the method for counting stored dead letters.
+func (r *deadLetterRepo) CountDeadLetters(ctx context.Context) (uint64, error) {
+	query := sq.Select("COUNT(*)").
+		From(deadLetterTableName).
+		RunWith(r.db).
+		PlaceholderFormat(sq.Dollar)
+
+	var total uint64
+	err := query.QueryRowContext(ctx).Scan(&total)
+
+	return total, err
+}
+
+// ListDeadLetters is the method for fetching the page of dead letters from the oldest
+// to the newest together with their total number.
+func (r *deadLetterRepo) ListDeadLetters(ctx context.Context, limit, offset uint64) ([]models.DeadLetter, uint64, error) {
+	total, err := r.CountDeadLetters(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	query := sq.Select(deadLetterColumns...).
+		From(deadLetterTableName).
+		OrderBy("id").
+		Limit(limit).
+		Offset(offset).
+		RunWith(r.db).
+		PlaceholderFormat(sq.Dollar)
+
+	rows, err := query.QueryContext(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	letters := make([]models.DeadLetter, 0, limit)
+	for rows.Next() {
+		var letter models.DeadLetter
+		if err = scanDeadLetter(rows, &letter); err != nil {
+			return nil, 0, err
+		}
+
+		letters = append(letters, letter)
+	}
+
+	return letters, total, rows.Err()
+}
+
+// UpdateDeadLetter is the method for storing edited team, sinks, error and attempts of the dead letter.
+func (r *deadLetterRepo) UpdateDeadLetter(ctx context.Context, letter *models.DeadLetter) error {
+	query := sq.Update(deadLetterTableName).
+		Set("name", letter.Team.Name).
+		Set("description", letter.Team.Description).
+		Set("sinks", strings.Join(letter.Sinks, ",")).
+		Set("error", letter.Error).
+		Set("attempts", letter.Attempts).
+		Set("updated_at", sq.Expr("NOW()")).
+		Where(sq.Eq{"id": letter.Id}).
+		Suffix("RETURNING updated_at").
+		RunWith(r.db).
+		PlaceholderFormat(sq.Dollar)
+
+	return query.QueryRowContext(ctx).Scan(&letter.UpdatedAt)
+}
+
+// RemoveDeadLetter is the method for discarding the dead letter.
+// It returns false if there is no such letter.
+func (r *deadLetterRepo) RemoveDeadLetter(ctx context.Context, letterId uint64) (bool, error) {
+	query := sq.Delete(deadLetterTableName).
+		Where(sq.Eq{"id": letterId}).
+		RunWith(r.db).
+		PlaceholderFormat(sq.Dollar)
+
+	result, err := query.ExecContext(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+
+	return affected > 0, err
+}
+
+func scanDeadLetter(row sq.RowScanner, letter *models.DeadLetter) error {
+	var sinks string
+	err := row.Scan(
+		&letter.Id,
+		&letter.Team.Id,
+		&letter.Team.ExternalId,
+		&letter.Team.Name,
+		&letter.Team.Description,
+		&sinks,
+		&letter.Error,
+		&letter.Attempts,
+		&letter.CreatedAt,
+		&letter.UpdatedAt,
+	)
+	if err == nil && sinks != "" {
+		letter.Sinks = strings.Split(sinks, ",")
+	}
+
+	return err
+}
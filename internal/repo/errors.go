@@ -0,0 +1,89 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/jackc/pgconn"
+	"strings"
+)
+
+// ErrExternalIdTaken is returned by UpdateTeam when the external id belongs to another team.
+var ErrExternalIdTaken = errors.New("external id is taken by another team")
+
+// externalIdIndex is the name of the unique index on external id of the team.
+const externalIdIndex = "ux_team_external_id"
+
+// transientClasses is the list of SQLSTATE classes and codes that
+// signal the statement may succeed when repeated.
+var transientClasses = []string{
+	"08",    // connection exception
+	"40",    // transaction rollback: serialization failure, deadlock
+	"53",    // insufficient resources
+	"57P",   // operator intervention: admin shutdown, crash shutdown, cannot connect now
+	"55P03", // lock not available
+	"58",    // system error
+}
+
+// IsTransient is the method for classifying storage errors.
+// It returns true if repeating the same statement may succeed, e.g. on
+// lost connection or serialization failure, and false if the statement
+// itself is invalid for the database, e.g. on constraint violation.
+// Errors not coming from the database server are considered transient.
+func IsTransient(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return false
+	}
+
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return true
+	}
+
+	for _, class := range transientClasses {
+		if strings.HasPrefix(pgErr.Code, class) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// serializationCodes is the list of SQLSTATE codes after which
+// the whole transaction may succeed when repeated.
+var serializationCodes = []string{
+	"40001", // serialization failure
+	"40P01", // deadlock detected
+}
+
+// IsSerializationFailure is the method for checking whether the transaction
+// failed due to concurrent transactions and is worth repeating from the start.
+func IsSerializationFailure(err error) bool {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return false
+	}
+
+	for _, code := range serializationCodes {
+		if pgErr.Code == code {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isExternalIdTaken is the method for checking whether the statement
+// violated uniqueness of external ids of teams.
+func isExternalIdTaken(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23505" && pgErr.ConstraintName == externalIdIndex
+}
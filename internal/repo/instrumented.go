@@ -0,0 +1,173 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/ext"
+	"github.com/ozoncp/ocp-team-api/internal/metrics"
+	"github.com/ozoncp/ocp-team-api/internal/models"
+	"github.com/rs/zerolog/log"
+	"time"
+)
+
+// InstrumentOption is the type of optional settings passed to NewInstrumentedRepo.
+type InstrumentOption func(r *instrumentedRepo)
+
+// WithSlowThreshold is the option logging calls which take longer than threshold.
+// By default, slow calls are not logged.
+func WithSlowThreshold(threshold time.Duration) InstrumentOption {
+	return func(r *instrumentedRepo) {
+		r.slowThreshold = threshold
+	}
+}
+
+// instrumentedRepo is the struct that implements Repo interface measuring calls of the next repo.
+// Every call is observed in the latency histogram and the error counter by method and is run
+// in the span, which is the child of the span of the context. Statements of the postgres
+// and sqlite repos get their own spans below it.
+type instrumentedRepo struct {
+	next          Repo
+	slowThreshold time.Duration
+}
+
+// NewInstrumentedRepo is the constructor method for instrumentedRepo struct.
+func NewInstrumentedRepo(next Repo, opts ...InstrumentOption) *instrumentedRepo {
+	r := &instrumentedRepo{next: next}
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
+}
+
+// start is the method starting the span of the call. The returned function
+// finishes the span and observes the call, sql.ErrNoRows is not counted as error.
+func (r *instrumentedRepo) start(ctx context.Context, method string) (context.Context, func(err error)) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repo."+method)
+	started := time.Now()
+
+	return ctx, func(err error) {
+		duration := time.Since(started)
+		failed := err != nil && !errors.Is(err, sql.ErrNoRows)
+
+		if failed {
+			ext.LogError(span, err)
+		}
+		span.Finish()
+
+		metrics.ObserveRepoCall(method, duration, failed)
+
+		if r.slowThreshold > 0 && duration > r.slowThreshold {
+			log.Warn().Err(err).Str("method", method).Dur("duration", duration).Msg("slow repo call")
+		}
+	}
+}
+
+// CreateTeam is the method calling CreateTeam of the next repo.
+func (r *instrumentedRepo) CreateTeam(ctx context.Context, team *models.Team) (err error) {
+	ctx, done := r.start(ctx, "CreateTeam")
+	defer func() { done(err) }()
+
+	return r.next.CreateTeam(ctx, team)
+}
+
+// CreateTeams is the method calling CreateTeams of the next repo.
+func (r *instrumentedRepo) CreateTeams(ctx context.Context, teams []models.Team) (ids []uint64, err error) {
+	ctx, done := r.start(ctx, "CreateTeams")
+	defer func() { done(err) }()
+
+	return r.next.CreateTeams(ctx, teams)
+}
+
+// BulkCreateTeams is the method calling BulkCreateTeams of the next repo.
+func (r *instrumentedRepo) BulkCreateTeams(ctx context.Context, teams []models.Team) (ids []uint64, err error) {
+	ctx, done := r.start(ctx, "BulkCreateTeams")
+	defer func() { done(err) }()
+
+	return r.next.BulkCreateTeams(ctx, teams)
+}
+
+// UpsertTeams is the method calling UpsertTeams of the next repo.
+func (r *instrumentedRepo) UpsertTeams(ctx context.Context, teams []models.Team) (results []UpsertResult, err error) {
+	ctx, done := r.start(ctx, "UpsertTeams")
+	defer func() { done(err) }()
+
+	return r.next.UpsertTeams(ctx, teams)
+}
+
+// GetTeam is the method calling GetTeam of the next repo.
+func (r *instrumentedRepo) GetTeam(ctx context.Context, teamId uint64) (team *models.Team, err error) {
+	ctx, done := r.start(ctx, "GetTeam")
+	defer func() { done(err) }()
+
+	return r.next.GetTeam(ctx, teamId)
+}
+
+// CountTeams is the method calling CountTeams of the next repo.
+func (r *instrumentedRepo) CountTeams(ctx context.Context) (total uint64, err error) {
+	ctx, done := r.start(ctx, "CountTeams")
+	defer func() { done(err) }()
+
+	return r.next.CountTeams(ctx)
+}
+
+// ListTeams is the method calling ListTeams of the next repo.
+func (r *instrumentedRepo) ListTeams(ctx context.Context, limit, offset uint64) (teams []models.Team, total uint64, err error) {
+	ctx, done := r.start(ctx, "ListTeams")
+	defer func() { done(err) }()
+
+	return r.next.ListTeams(ctx, limit, offset)
+}
+
+// ListTeamsAfter is the method calling ListTeamsAfter of the next repo.
+func (r *instrumentedRepo) ListTeamsAfter(ctx context.Context, afterId, limit uint64) (teams []models.Team, err error) {
+	ctx, done := r.start(ctx, "ListTeamsAfter")
+	defer func() { done(err) }()
+
+	return r.next.ListTeamsAfter(ctx, afterId, limit)
+}
+
+// RemoveTeam is the method calling RemoveTeam of the next repo.
+func (r *instrumentedRepo) RemoveTeam(ctx context.Context, teamId uint64) (err error) {
+	ctx, done := r.start(ctx, "RemoveTeam")
+	defer func() { done(err) }()
+
+	return r.next.RemoveTeam(ctx, teamId)
+}
+
+// UpdateTeam is the method calling UpdateTeam of the next repo.
+func (r *instrumentedRepo) UpdateTeam(ctx context.Context, team *models.Team) (err error) {
+	ctx, done := r.start(ctx, "UpdateTeam")
+	defer func() { done(err) }()
+
+	return r.next.UpdateTeam(ctx, team)
+}
+
+// SearchTeams is the method calling SearchTeams of the next repo.
+func (r *instrumentedRepo) SearchTeams(ctx context.Context, query SearchQuery) (result *SearchResult, err error) {
+	ctx, done := r.start(ctx, "SearchTeams")
+	defer func() { done(err) }()
+
+	return r.next.SearchTeams(ctx, query)
+}
+
+// SuggestTeams is the method calling SuggestTeams of the next repo.
+func (r *instrumentedRepo) SuggestTeams(ctx context.Context, prefix string, limit uint64) (names []string, err error) {
+	ctx, done := r.start(ctx, "SuggestTeams")
+	defer func() { done(err) }()
+
+	return r.next.SuggestTeams(ctx, prefix, limit)
+}
+
+// WithTx is the method calling WithTx of the next repo. The calls made in the transaction
+// are instrumented as well and their spans are the children of the span of the transaction.
+func (r *instrumentedRepo) WithTx(ctx context.Context, fn func(tx Repo) error, opts ...TxOption) (err error) {
+	ctx, done := r.start(ctx, "WithTx")
+	defer func() { done(err) }()
+
+	return r.next.WithTx(ctx, func(tx Repo) error {
+		return fn(&instrumentedRepo{next: tx, slowThreshold: r.slowThreshold})
+	}, opts...)
+}
@@ -0,0 +1,100 @@
+package repo_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/mocktracer"
+	"github.com/ozoncp/ocp-team-api/internal/mocks"
+	"github.com/ozoncp/ocp-team-api/internal/models"
+	"github.com/ozoncp/ocp-team-api/internal/repo"
+)
+
+var _ = Describe("InstrumentedRepo", func() {
+
+	var (
+		ctrl     *gomock.Controller
+		ctx      context.Context
+		tracer   *mocktracer.MockTracer
+		parent   opentracing.Span
+		mockRepo *mocks.MockRepo
+		r        repo.Repo
+	)
+
+	team := &models.Team{Id: 1, Name: "Name"}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		tracer = mocktracer.New()
+		opentracing.SetGlobalTracer(tracer)
+		parent = tracer.StartSpan("GetTeamV1")
+		ctx = opentracing.ContextWithSpan(context.Background(), parent)
+		mockRepo = mocks.NewMockRepo(ctrl)
+		r = repo.NewInstrumentedRepo(mockRepo)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+		opentracing.SetGlobalTracer(opentracing.NoopTracer{})
+	})
+
+	It("runs the call in the child span", func() {
+		mockRepo.EXPECT().GetTeam(gomock.Any(), uint64(1)).DoAndReturn(
+			func(ctx context.Context, _ uint64) (*models.Team, error) {
+				Expect(opentracing.SpanFromContext(ctx)).ShouldNot(Equal(parent))
+				return team, nil
+			},
+		)
+
+		Expect(r.GetTeam(ctx, 1)).Should(Equal(team))
+
+		spans := tracer.FinishedSpans()
+		Expect(spans).Should(HaveLen(1))
+		Expect(spans[0].OperationName).Should(Equal("repo.GetTeam"))
+		Expect(spans[0].ParentID).Should(Equal(parent.Context().(mocktracer.MockSpanContext).SpanID))
+		Expect(spans[0].Tag("error")).Should(BeNil())
+	})
+
+	It("marks the span of the failed call", func() {
+		errFailed := errors.New("failed")
+		mockRepo.EXPECT().RemoveTeam(gomock.Any(), uint64(1)).Return(errFailed)
+
+		Expect(r.RemoveTeam(ctx, 1)).Should(MatchError(errFailed))
+
+		spans := tracer.FinishedSpans()
+		Expect(spans).Should(HaveLen(1))
+		Expect(spans[0].Tag("error")).Should(Equal(true))
+	})
+
+	It("does not treat missing team as failure", func() {
+		mockRepo.EXPECT().GetTeam(gomock.Any(), uint64(1)).Return(nil, sql.ErrNoRows)
+
+		_, err := r.GetTeam(ctx, 1)
+		Expect(err).Should(MatchError(sql.ErrNoRows))
+		Expect(tracer.FinishedSpans()[0].Tag("error")).Should(BeNil())
+	})
+
+	It("instruments calls made in the transaction", func() {
+		mockTx := mocks.NewMockRepo(ctrl)
+		mockRepo.EXPECT().WithTx(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, fn func(tx repo.Repo) error, _ ...repo.TxOption) error {
+				return fn(mockTx)
+			},
+		)
+		mockTx.EXPECT().UpdateTeam(gomock.Any(), team).Return(nil)
+
+		err := r.WithTx(ctx, func(tx repo.Repo) error {
+			return tx.UpdateTeam(ctx, team)
+		})
+		Expect(err).Should(BeNil())
+
+		spans := tracer.FinishedSpans()
+		Expect(spans).Should(HaveLen(2))
+		Expect(spans[0].OperationName).Should(Equal("repo.UpdateTeam"))
+		Expect(spans[1].OperationName).Should(Equal("repo.WithTx"))
+	})
+})
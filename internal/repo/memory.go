@@ -0,0 +1,600 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/ozoncp/ocp-team-api/internal/models"
+	"github.com/ozoncp/ocp-team-api/internal/utils"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Weights of matches in name and description mirroring setweight 'A' and 'B' of the search index.
+const (
+	nameMatchWeight        = 1.0
+	descriptionMatchWeight = 0.4
+)
+
+// memoryState is the data of the in-memory repo. Ids grow monotonically,
+// so ids keeps teams in the order of creation which is the order of id.
+type memoryState struct {
+	lastId      uint64
+	ids         []uint64
+	teams       map[uint64]models.Team
+	externalIds map[string]uint64
+}
+
+func (s *memoryState) clone() *memoryState {
+	c := &memoryState{
+		lastId:      s.lastId,
+		ids:         append([]uint64(nil), s.ids...),
+		teams:       make(map[uint64]models.Team, len(s.teams)),
+		externalIds: make(map[string]uint64, len(s.externalIds)),
+	}
+	for id, team := range s.teams {
+		c.teams[id] = team
+	}
+	for externalId, id := range s.externalIds {
+		c.externalIds[externalId] = id
+	}
+
+	return c
+}
+
+// memoryRepo is the struct that implements Repo interface keeping teams in memory.
+type memoryRepo struct {
+	mu    sync.RWMutex
+	state *memoryState
+	inTx  bool
+}
+
+// NewMemoryRepo is the constructor method for the thread-safe Repo keeping teams in memory.
+// It has the semantics of the database repo: removed teams are soft deleted, teams are
+// listed in the order of id, GetTeam returns sql.ErrNoRows for unknown and removed teams.
+// Search matches whole words ignoring case without stemming.
+func NewMemoryRepo() *memoryRepo {
+	return &memoryRepo{
+		state: &memoryState{
+			teams:       make(map[uint64]models.Team),
+			externalIds: make(map[string]uint64),
+		},
+	}
+}
+
+// insert is the method that stores new team and returns its id. Caller must hold the lock.
+func (r *memoryRepo) insert(team models.Team) uint64 {
+	r.state.lastId++
+	team.Id = r.state.lastId
+	team.IsDeleted = false
+
+	r.state.ids = append(r.state.ids, team.Id)
+	r.state.teams[team.Id] = team
+	if team.ExternalId != "" {
+		r.state.externalIds[team.ExternalId] = team.Id
+	}
+
+	return team.Id
+}
+
+// CreateTeam is the method for creating new team.
+func (r *memoryRepo) CreateTeam(_ context.Context, team *models.Team) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	team.Id = r.insert(models.Team{Name: team.Name, Description: team.Description})
+
+	return nil
+}
+
+// CreateTeams is the method for creating multiple teams.
+// External ids are ignored, they are set by UpsertTeams only.
+// It returns ids in the order of teams.
+func (r *memoryRepo) CreateTeams(_ context.Context, teams []models.Team) ([]uint64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	ids := make([]uint64, 0, len(teams))
+	for _, team := range teams {
+		ids = append(ids, r.insert(models.Team{Name: team.Name, Description: team.Description}))
+	}
+
+	return ids, nil
+}
+
+// BulkCreateTeams is the method for creating large number of teams, it is the same as CreateTeams.
+func (r *memoryRepo) BulkCreateTeams(ctx context.Context, teams []models.Team) ([]uint64, error) {
+	return r.CreateTeams(ctx, teams)
+}
+
+// UpsertTeams is the method for creating or updating multiple teams keyed by external id.
+// Soft deleted teams are restored. It returns results in the order of teams.
+func (r *memoryRepo) UpsertTeams(_ context.Context, teams []models.Team) ([]UpsertResult, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	seen := make(map[string]struct{}, len(teams))
+	for _, team := range teams {
+		if _, ok := seen[team.ExternalId]; ok {
+			return nil, fmt.Errorf("duplicate external id %s", team.ExternalId)
+		}
+		seen[team.ExternalId] = struct{}{}
+	}
+
+	results := make([]UpsertResult, 0, len(teams))
+	for _, team := range teams {
+		result := UpsertResult{ExternalId: team.ExternalId}
+
+		id, ok := r.state.externalIds[team.ExternalId]
+		if !ok {
+			result.Id = r.insert(models.Team{
+				Name:        team.Name,
+				Description: team.Description,
+				ExternalId:  team.ExternalId,
+			})
+			result.Status = Created
+			results = append(results, result)
+			continue
+		}
+
+		existing := r.state.teams[id]
+		result.Id = id
+
+		switch {
+		case existing.IsDeleted:
+			result.Status = Created
+		case existing.Name != team.Name || existing.Description != team.Description:
+			result.Status = Updated
+		default:
+			result.Status = Unchanged
+			results = append(results, result)
+			continue
+		}
+
+		existing.Name = team.Name
+		existing.Description = team.Description
+		existing.IsDeleted = false
+		r.state.teams[id] = existing
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// GetTeam is the method for fetching the team by id.
+// It returns sql.ErrNoRows if the team does not exist or is removed.
+func (r *memoryRepo) GetTeam(_ context.Context, teamId uint64) (*models.Team, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	team, ok := r.state.teams[teamId]
+	if !ok || team.IsDeleted {
+		return nil, sql.ErrNoRows
+	}
+
+	return &team, nil
+}
+
+// CountTeams is the method for counting teams that are not removed.
+func (r *memoryRepo) CountTeams(_ context.Context) (uint64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.count(), nil
+}
+
+func (r *memoryRepo) count() uint64 {
+	var total uint64
+	for _, team := range r.state.teams {
+		if !team.IsDeleted {
+			total++
+		}
+	}
+
+	return total
+}
+
+// ListTeams is the method for fetching the page of teams ordered by id
+// together with the number of teams that are not removed.
+func (r *memoryRepo) ListTeams(_ context.Context, limit, offset uint64) ([]models.Team, uint64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var teams []models.Team
+	var skipped uint64
+	for _, id := range r.state.ids {
+		if uint64(len(teams)) >= limit {
+			break
+		}
+
+		team := r.state.teams[id]
+		if team.IsDeleted {
+			continue
+		}
+		if skipped < offset {
+			skipped++
+			continue
+		}
+		teams = append(teams, team)
+	}
+
+	return teams, r.count(), nil
+}
+
+// ListTeamsAfter is the method for keyset pagination over teams ordered by id.
+// It returns at most limit teams which id is greater than afterId.
+func (r *memoryRepo) ListTeamsAfter(_ context.Context, afterId, limit uint64) ([]models.Team, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	start := sort.Search(len(r.state.ids), func(i int) bool {
+		return r.state.ids[i] > afterId
+	})
+
+	teams := make([]models.Team, 0, limit)
+	for _, id := range r.state.ids[start:] {
+		if uint64(len(teams)) >= limit {
+			break
+		}
+		if team := r.state.teams[id]; !team.IsDeleted {
+			teams = append(teams, team)
+		}
+	}
+
+	return teams, nil
+}
+
+// RemoveTeam is the method that marks the team as deleted.
+// Removing unknown team is not an error.
+func (r *memoryRepo) RemoveTeam(_ context.Context, teamId uint64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if team, ok := r.state.teams[teamId]; ok {
+		team.IsDeleted = true
+		r.state.teams[teamId] = team
+	}
+
+	return nil
+}
+
+// UpdateTeam is the method that updates name and description of the team that is not removed.
+// Non-empty external id replaces the current one, empty external id keeps it.
+// Updating unknown team is not an error. It returns ErrExternalIdTaken if the external id
+// belongs to another team.
+func (r *memoryRepo) UpdateTeam(_ context.Context, team *models.Team) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	existing, ok := r.state.teams[team.Id]
+	if !ok || existing.IsDeleted {
+		return nil
+	}
+
+	if team.ExternalId != "" && team.ExternalId != existing.ExternalId {
+		if _, taken := r.state.externalIds[team.ExternalId]; taken {
+			return ErrExternalIdTaken
+		}
+
+		delete(r.state.externalIds, existing.ExternalId)
+		r.state.externalIds[team.ExternalId] = team.Id
+		existing.ExternalId = team.ExternalId
+	}
+
+	existing.Name = team.Name
+	existing.Description = team.Description
+	r.state.teams[team.Id] = existing
+
+	return nil
+}
+
+// SearchTeams is the method for searching teams by words of the query.
+// Plain search matches teams containing all the words, phrase search matches
+// teams containing the words next to each other in name or description.
+// Web search matches any of alternatives separated by OR, prefix search matches
+// teams containing words starting with the words of the query.
+// Teams are ordered by rank where matches in name weigh more, matched words
+// are highlighted with <b> tags like ts_headline does.
+func (r *memoryRepo) SearchTeams(_ context.Context, query SearchQuery) (*SearchResult, error) {
+	search, err := newMemorySearch(query.Text, query.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	cursor, err := decodePageToken(query.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var matched []SearchHit
+	for _, id := range r.state.ids {
+		team := r.state.teams[id]
+		if team.IsDeleted {
+			continue
+		}
+
+		name, description := tokenize(team.Name), tokenize(team.Description)
+		if !search.match(name, description) {
+			continue
+		}
+
+		score := nameMatchWeight*float64(countMatches(name, search.hit)) +
+			descriptionMatchWeight*float64(countMatches(description, search.hit))
+		if score < query.MinScore {
+			continue
+		}
+
+		team.Name = headline(team.Name, search.hit)
+		team.Description = headline(team.Description, search.hit)
+		matched = append(matched, SearchHit{Team: team, Score: score})
+	}
+
+	sort.Slice(matched, func(i, j int) bool {
+		if matched[i].Score != matched[j].Score {
+			return matched[i].Score > matched[j].Score
+		}
+		return matched[i].Team.Id < matched[j].Team.Id
+	})
+
+	hits := matched
+	if cursor != nil {
+		hits = hits[sort.Search(len(hits), func(i int) bool { return cursor.after(hits[i]) }):]
+	}
+	if limit := pageLimit(query.Limit); limit > 0 && uint64(len(hits)) > limit {
+		hits = hits[:limit]
+	}
+
+	return newSearchResult(hits, query.Limit, uint64(len(matched))), nil
+}
+
+// SuggestTeams is the method for completing names of teams which are not deleted.
+// It returns at most limit distinct names starting with prefix case-insensitively, the shortest first.
+func (r *memoryRepo) SuggestTeams(_ context.Context, prefix string, limit uint64) ([]string, error) {
+	prefix = strings.ToLower(prefix)
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	seen := make(map[string]struct{})
+	names := []string{}
+	for _, id := range r.state.ids {
+		team := r.state.teams[id]
+		if _, ok := seen[team.Name]; ok || team.IsDeleted || !strings.HasPrefix(strings.ToLower(team.Name), prefix) {
+			continue
+		}
+		seen[team.Name] = struct{}{}
+		names = append(names, team.Name)
+	}
+
+	sort.Slice(names, func(i, j int) bool {
+		if li, lj := utf8.RuneCountInString(names[i]), utf8.RuneCountInString(names[j]); li != lj {
+			return li < lj
+		}
+		return names[i] < names[j]
+	})
+	if uint64(len(names)) > limit {
+		names = names[:limit]
+	}
+
+	return names, nil
+}
+
+// WithTx is the method for running fn as the unit of work. Transactions are serialized
+// and see no concurrent changes, so the options are ignored. Changes made by fn are
+// discarded if it fails. Nested calls join the outer transaction.
+func (r *memoryRepo) WithTx(ctx context.Context, fn func(tx Repo) error, _ ...TxOption) error {
+	if r.inTx {
+		return fn(r)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	tx := &memoryRepo{state: r.state.clone(), inTx: true}
+	if err := fn(tx); err != nil {
+		return err
+	}
+
+	r.state = tx.state
+
+	return nil
+}
+
+// tokenize is the method that splits the text into lower case words.
+func tokenize(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(c rune) bool {
+		return !unicode.IsLetter(c) && !unicode.IsDigit(c)
+	})
+}
+
+// memorySearch is the struct representing the parsed query of memoryRepo search.
+// match reports whether the team with the tokens of name and description matches the query,
+// hit reports whether the token is the matched word counted in the rank and highlighted.
+type memorySearch struct {
+	match func(name, description []string) bool
+	hit   func(token string) bool
+}
+
+// newMemorySearch is the constructor method for memorySearch struct.
+// It returns error if the search type is unknown.
+func newMemorySearch(query string, searchType utils.SearchType) (*memorySearch, error) {
+	switch searchType {
+	case utils.Plain, utils.Phrase:
+		words := tokenize(query)
+		phrase := searchType == utils.Phrase
+		return &memorySearch{
+			match: func(name, description []string) bool {
+				return len(words) > 0 && containsTerm(name, description, words, phrase)
+			},
+			hit: wordSet(words),
+		}, nil
+	case utils.Websearch:
+		groups := parseWebsearch(query)
+		var words []string
+		for _, group := range groups {
+			for _, term := range group {
+				if !term.negated {
+					words = append(words, term.words...)
+				}
+			}
+		}
+		return &memorySearch{
+			match: func(name, description []string) bool {
+				for _, group := range groups {
+					if containsGroup(name, description, group) {
+						return true
+					}
+				}
+				return false
+			},
+			hit: wordSet(words),
+		}, nil
+	case utils.Prefix:
+		words := tokenize(query)
+		hit := func(token string) bool {
+			for _, word := range words {
+				if strings.HasPrefix(token, word) {
+					return true
+				}
+			}
+			return false
+		}
+		return &memorySearch{
+			match: func(name, description []string) bool {
+				for _, word := range words {
+					if !containsPrefix(name, word) && !containsPrefix(description, word) {
+						return false
+					}
+				}
+				return len(words) > 0
+			},
+			hit: hit,
+		}, nil
+	default:
+		return nil, errors.New("incorrect search type")
+	}
+}
+
+// containsTerm is the method reporting whether name or description contain the words,
+// either anywhere or next to each other as the phrase.
+func containsTerm(name, description, words []string, phrase bool) bool {
+	if phrase {
+		return containsPhrase(name, words) || containsPhrase(description, words)
+	}
+	return containsAll(append(append([]string{}, name...), description...), words)
+}
+
+// containsGroup is the method reporting whether the team matches all terms of the web search alternative.
+func containsGroup(name, description []string, group []webTerm) bool {
+	for _, term := range group {
+		if containsTerm(name, description, term.words, len(term.words) > 1) == term.negated {
+			return false
+		}
+	}
+	return true
+}
+
+func containsPrefix(tokens []string, prefix string) bool {
+	for _, token := range tokens {
+		if strings.HasPrefix(token, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func wordSet(words []string) func(token string) bool {
+	set := make(map[string]struct{}, len(words))
+	for _, word := range words {
+		set[word] = struct{}{}
+	}
+
+	return func(token string) bool {
+		_, ok := set[token]
+		return ok
+	}
+}
+
+func containsAll(tokens, words []string) bool {
+	set := make(map[string]struct{}, len(tokens))
+	for _, token := range tokens {
+		set[token] = struct{}{}
+	}
+
+	for _, word := range words {
+		if _, ok := set[word]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+func containsPhrase(tokens, words []string) bool {
+	for i := 0; i+len(words) <= len(tokens); i++ {
+		ok := true
+		for j, word := range words {
+			if tokens[i+j] != word {
+				ok = false
+				break
+			}
+		}
+		if ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+func countMatches(tokens []string, hit func(token string) bool) int {
+	count := 0
+	for _, token := range tokens {
+		if hit(token) {
+			count++
+		}
+	}
+
+	return count
+}
+
+// headline is the method that wraps the words of the text matching the query with <b> tags.
+func headline(text string, hit func(token string) bool) string {
+	var (
+		b     strings.Builder
+		start = -1
+	)
+	flush := func(end int) {
+		if start < 0 {
+			return
+		}
+		word := text[start:end]
+		if hit(strings.ToLower(word)) {
+			b.WriteString("<b>" + word + "</b>")
+		} else {
+			b.WriteString(word)
+		}
+		start = -1
+	}
+
+	for i, c := range text {
+		if unicode.IsLetter(c) || unicode.IsDigit(c) {
+			if start < 0 {
+				start = i
+			}
+			continue
+		}
+		flush(i)
+		b.WriteRune(c)
+	}
+	flush(len(text))
+
+	return b.String()
+}
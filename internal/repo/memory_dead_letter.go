@@ -0,0 +1,116 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"github.com/ozoncp/ocp-team-api/internal/models"
+	"sort"
+	"sync"
+	"time"
+)
+
+// memoryDeadLetterRepo is the struct that implements DeadLetterRepo interface keeping letters in memory.
+type memoryDeadLetterRepo struct {
+	mu      sync.Mutex
+	lastId  uint64
+	letters map[uint64]models.DeadLetter
+}
+
+// NewMemoryDeadLetterRepo is the constructor method for the thread-safe DeadLetterRepo keeping letters in memory.
+func NewMemoryDeadLetterRepo() *memoryDeadLetterRepo {
+	return &memoryDeadLetterRepo{letters: make(map[uint64]models.DeadLetter)}
+}
+
+// AddDeadLetter is the method for storing the team that could not be persisted.
+func (r *memoryDeadLetterRepo) AddDeadLetter(_ context.Context, letter *models.DeadLetter) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.lastId++
+	letter.Id = r.lastId
+	letter.CreatedAt = time.Now()
+	letter.UpdatedAt = letter.CreatedAt
+
+	stored := *letter
+	stored.Sinks = append([]string(nil), letter.Sinks...)
+	r.letters[letter.Id] = stored
+
+	return nil
+}
+
+// GetDeadLetter is the method for fetching the dead letter by id.
+// It returns sql.ErrNoRows if there is no such letter.
+func (r *memoryDeadLetterRepo) GetDeadLetter(_ context.Context, letterId uint64) (*models.DeadLetter, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	letter, ok := r.letters[letterId]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+
+	return &letter, nil
+}
+
+// CountDeadLetters is the method for counting stored dead letters.
+func (r *memoryDeadLetterRepo) CountDeadLetters(_ context.Context) (uint64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return uint64(len(r.letters)), nil
+}
+
+// ListDeadLetters is the method for fetching the page of dead letters from the oldest
+// to the newest together with their total number.
+func (r *memoryDeadLetterRepo) ListDeadLetters(_ context.Context, limit, offset uint64) ([]models.DeadLetter, uint64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	ids := make([]uint64, 0, len(r.letters))
+	for id := range r.letters {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	letters := make([]models.DeadLetter, 0, limit)
+	for i := offset; i < uint64(len(ids)) && uint64(len(letters)) < limit; i++ {
+		letters = append(letters, r.letters[ids[i]])
+	}
+
+	return letters, uint64(len(ids)), nil
+}
+
+// UpdateDeadLetter is the method for storing edited team, sinks, error and attempts of the dead letter.
+// It returns sql.ErrNoRows if there is no such letter.
+func (r *memoryDeadLetterRepo) UpdateDeadLetter(_ context.Context, letter *models.DeadLetter) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.letters[letter.Id]
+	if !ok {
+		return sql.ErrNoRows
+	}
+
+	stored.Team.Name = letter.Team.Name
+	stored.Team.Description = letter.Team.Description
+	stored.Sinks = append([]string(nil), letter.Sinks...)
+	stored.Error = letter.Error
+	stored.Attempts = letter.Attempts
+	stored.UpdatedAt = time.Now()
+	r.letters[letter.Id] = stored
+	letter.UpdatedAt = stored.UpdatedAt
+
+	return nil
+}
+
+// RemoveDeadLetter is the method for discarding the dead letter.
+// It returns false if there is no such letter.
+func (r *memoryDeadLetterRepo) RemoveDeadLetter(_ context.Context, letterId uint64) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	_, ok := r.letters[letterId]
+	delete(r.letters, letterId)
+
+	return ok, nil
+}
@@ -0,0 +1,45 @@
+package repo_test
+
+import (
+	"context"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/ozoncp/ocp-team-api/internal/models"
+	"github.com/ozoncp/ocp-team-api/internal/repo"
+)
+
+var _ = Describe("MemoryRepo", func() {
+	repoConformance(func() repo.Repo {
+		return repo.NewMemoryRepo()
+	})
+})
+
+var _ = Describe("MemoryWebhookRepo", func() {
+
+	var (
+		ctx context.Context
+		r   repo.WebhookRepo
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		r = repo.NewMemoryWebhookRepo()
+	})
+
+	It("disables the subscription after disableAfter failures", func() {
+		webhook := &models.Webhook{URL: "https://example.com/hook", IsEnabled: true}
+		Expect(r.CreateWebhook(ctx, webhook)).Should(Succeed())
+
+		Expect(r.RegisterFailure(ctx, webhook.Id, 2)).Should(BeFalse())
+		Expect(r.RegisterFailure(ctx, webhook.Id, 2)).Should(BeTrue())
+	})
+
+	It("never disables the subscription when disableAfter is zero", func() {
+		webhook := &models.Webhook{URL: "https://example.com/hook", IsEnabled: true}
+		Expect(r.CreateWebhook(ctx, webhook)).Should(Succeed())
+
+		for i := 0; i < 10; i++ {
+			Expect(r.RegisterFailure(ctx, webhook.Id, 0)).Should(BeFalse())
+		}
+	})
+})
@@ -0,0 +1,137 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/ozoncp/ocp-team-api/internal/models"
+	"sort"
+	"sync"
+	"time"
+)
+
+// memoryWebhookRepo is the struct that implements WebhookRepo interface keeping data in memory.
+type memoryWebhookRepo struct {
+	mu             sync.Mutex
+	lastId         uint64
+	lastDeliveryId uint64
+	webhooks       map[uint64]models.Webhook
+	deliveries     map[uint64][]models.WebhookDelivery
+}
+
+// NewMemoryWebhookRepo is the constructor method for the thread-safe WebhookRepo
+// keeping subscriptions and their delivery log in memory.
+func NewMemoryWebhookRepo() *memoryWebhookRepo {
+	return &memoryWebhookRepo{
+		webhooks:   make(map[uint64]models.Webhook),
+		deliveries: make(map[uint64][]models.WebhookDelivery),
+	}
+}
+
+// CreateWebhook is the method for creating new enabled webhook subscription.
+func (r *memoryWebhookRepo) CreateWebhook(_ context.Context, webhook *models.Webhook) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.lastId++
+	webhook.Id = r.lastId
+	webhook.IsEnabled = true
+	webhook.Failures = 0
+	webhook.Events = append([]string(nil), webhook.Events...)
+	r.webhooks[webhook.Id] = *webhook
+
+	return nil
+}
+
+// ListWebhooks is the method for fetching all webhook subscriptions ordered by id.
+func (r *memoryWebhookRepo) ListWebhooks(_ context.Context) ([]models.Webhook, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var webhooks []models.Webhook
+	for _, webhook := range r.webhooks {
+		webhooks = append(webhooks, webhook)
+	}
+	sort.Slice(webhooks, func(i, j int) bool {
+		return webhooks[i].Id < webhooks[j].Id
+	})
+
+	return webhooks, nil
+}
+
+// RemoveWebhook is the method that deletes webhook subscription with its delivery log.
+func (r *memoryWebhookRepo) RemoveWebhook(_ context.Context, webhookId uint64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.webhooks, webhookId)
+	delete(r.deliveries, webhookId)
+
+	return nil
+}
+
+// RegisterFailure is the method that increments the number of consecutive failures
+// of the subscription and disables it when the number reaches disableAfter.
+// Zero disableAfter never disables the subscription.
+// It returns true if the subscription is disabled and sql.ErrNoRows if there is no such subscription.
+func (r *memoryWebhookRepo) RegisterFailure(_ context.Context, webhookId uint64, disableAfter uint32) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	webhook, ok := r.webhooks[webhookId]
+	if !ok {
+		return false, sql.ErrNoRows
+	}
+
+	webhook.IsEnabled = webhook.IsEnabled && (disableAfter == 0 || webhook.Failures+1 < disableAfter)
+	webhook.Failures++
+	r.webhooks[webhookId] = webhook
+
+	return !webhook.IsEnabled, nil
+}
+
+// ResetFailures is the method that resets the number of consecutive failures.
+func (r *memoryWebhookRepo) ResetFailures(_ context.Context, webhookId uint64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if webhook, ok := r.webhooks[webhookId]; ok {
+		webhook.Failures = 0
+		r.webhooks[webhookId] = webhook
+	}
+
+	return nil
+}
+
+// AddDelivery is the method for appending record to the delivery log.
+func (r *memoryWebhookRepo) AddDelivery(_ context.Context, delivery *models.WebhookDelivery) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.webhooks[delivery.WebhookId]; !ok {
+		return fmt.Errorf("webhook %d does not exist", delivery.WebhookId)
+	}
+
+	r.lastDeliveryId++
+	delivery.Id = r.lastDeliveryId
+	delivery.DeliveredAt = time.Now()
+	r.deliveries[delivery.WebhookId] = append(r.deliveries[delivery.WebhookId], *delivery)
+
+	return nil
+}
+
+// ListDeliveries is the method for fetching the latest deliveries of the subscription,
+// the newest first.
+func (r *memoryWebhookRepo) ListDeliveries(_ context.Context, webhookId, limit uint64) ([]models.WebhookDelivery, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	history := r.deliveries[webhookId]
+
+	var deliveries []models.WebhookDelivery
+	for i := len(history) - 1; i >= 0 && uint64(len(deliveries)) < limit; i-- {
+		deliveries = append(deliveries, history[i])
+	}
+
+	return deliveries, nil
+}
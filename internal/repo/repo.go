@@ -3,36 +3,80 @@ package repo
 import (
 	"context"
 	"errors"
+	"fmt"
 	sq "github.com/Masterminds/squirrel"
 	"github.com/jmoiron/sqlx"
 	"github.com/ozoncp/ocp-team-api/internal/models"
 	"github.com/ozoncp/ocp-team-api/internal/utils"
+	"strings"
 )
 
 const (
 	tableName = "team"
 )
 
+// teamColumns are the columns selected into models.Team.
+var teamColumns = []string{"id", "name", "description", "COALESCE(external_id, '')"}
+
+// UpsertStatus is the outcome of upserting the single team.
+type UpsertStatus uint8
+
+const (
+	// Unchanged means the team with the external id already has the same data.
+	Unchanged UpsertStatus = iota
+	// Created means the team is inserted or restored after soft delete.
+	Created
+	// Updated means name or description of the team is changed.
+	Updated
+)
+
+// String is the method for converting UpsertStatus to string representation.
+func (s UpsertStatus) String() string {
+	switch s {
+	case Created:
+		return "Created"
+	case Updated:
+		return "Updated"
+	default:
+		return "Unchanged"
+	}
+}
+
+// UpsertResult is the struct representing outcome of upserting the single team.
+type UpsertResult struct {
+	Id         uint64
+	ExternalId string
+	Status     UpsertStatus
+}
+
 // Repo is the interface that wraps storage operations on team table.
 type Repo interface {
 	CreateTeam(ctx context.Context, team *models.Team) error
 	CreateTeams(ctx context.Context, teams []models.Team) ([]uint64, error)
+	BulkCreateTeams(ctx context.Context, teams []models.Team) ([]uint64, error)
+	UpsertTeams(ctx context.Context, teams []models.Team) ([]UpsertResult, error)
 	GetTeam(ctx context.Context, teamId uint64) (*models.Team, error)
 	CountTeams(ctx context.Context) (uint64, error)
 	ListTeams(ctx context.Context, limit, offset uint64) ([]models.Team, uint64, error)
+	ListTeamsAfter(ctx context.Context, afterId, limit uint64) ([]models.Team, error)
 	RemoveTeam(ctx context.Context, teamId uint64) error
 	UpdateTeam(ctx context.Context, team *models.Team) error
-	SearchTeams(ctx context.Context, query string, searchType utils.SearchType) ([]models.Team, error)
+	SearchTeams(ctx context.Context, query SearchQuery) (*SearchResult, error)
+	SuggestTeams(ctx context.Context, prefix string, limit uint64) ([]string, error)
+	WithTx(ctx context.Context, fn func(tx Repo) error, opts ...TxOption) error
 }
 
 // NewRepo is the constructor method for repo struct.
 func NewRepo(db *sqlx.DB) *repo {
-	return &repo{db}
+	return &repo{db: db, runner: traced(db)}
 }
 
 // repo is the struct that implements Repo interface through sqlx library.
+// Statements run with runner, which is either db or the transaction the repo is bound to.
 type repo struct {
-	db *sqlx.DB
+	db     *sqlx.DB
+	runner sq.StdSqlCtx
+	inTx   bool
 }
 
 // CreateTeam is the method for creating new team through SQL INSERT.
@@ -42,7 +86,7 @@ func (r *repo) CreateTeam(ctx context.Context, team *models.Team) error {
 		Columns("name", "description").
 		Values(team.Name, team.Description).
 		Suffix("RETURNING id").
-		RunWith(r.db).
+		RunWith(r.runner).
 		PlaceholderFormat(sq.Dollar)
 
 	err := query.QueryRowContext(ctx).Scan(&team.Id)
@@ -52,13 +96,13 @@ func (r *repo) CreateTeam(ctx context.Context, team *models.Team) error {
 
 // CreateTeams is the method for creating multiple teams through SQL INSERT.
 // It returns slice of uint64 ids (each number relates to generated id of
-// corresponding team).
+// corresponding team). External ids are ignored, they are set by UpsertTeams only.
 // It returns error if INSERT query failed.
 func (r *repo) CreateTeams(ctx context.Context, teams []models.Team) ([]uint64, error) {
 	query := sq.Insert(tableName).
 		Columns("name", "description").
 		Suffix("RETURNING id").
-		RunWith(r.db).
+		RunWith(r.runner).
 		PlaceholderFormat(sq.Dollar)
 
 	for _, team := range teams {
@@ -85,21 +129,85 @@ func (r *repo) CreateTeams(ctx context.Context, teams []models.Team) ([]uint64,
 	return ids, nil
 }
 
+// UpsertTeams is the method for creating or updating multiple teams keyed by external id
+// through SQL INSERT ... ON CONFLICT. Soft deleted teams are restored. Rows with the same
+// data are not touched. External ids must be unique within the call.
+// It returns results in the order of teams.
+// It returns error if the query failed.
+func (r *repo) UpsertTeams(ctx context.Context, teams []models.Team) ([]UpsertResult, error) {
+	if len(teams) == 0 {
+		return []UpsertResult{}, nil
+	}
+
+	values := make([]string, 0, len(teams))
+	args := make([]interface{}, 0, 3*len(teams))
+	for i, team := range teams {
+		values = append(values, fmt.Sprintf("(%d, $%d::VARCHAR, $%d::VARCHAR, $%d::TEXT)", i, 3*i+1, 3*i+2, 3*i+3))
+		args = append(args, team.ExternalId, team.Name, team.Description)
+	}
+
+	// existing sees the table before the statement, so it tells
+	// restored, updated and unchanged rows apart.
+	querySql := `WITH input(ord, external_id, name, description) AS (VALUES ` + strings.Join(values, ", ") + `),
+		existing AS (
+			SELECT t.id, t.external_id, t.is_deleted FROM team t JOIN input i ON t.external_id = i.external_id
+		),
+		upserted AS (
+			INSERT INTO team (external_id, name, description)
+			SELECT external_id, name, description FROM input ORDER BY ord
+			ON CONFLICT (external_id) DO UPDATE
+				SET name = EXCLUDED.name, description = EXCLUDED.description, is_deleted = FALSE
+				WHERE team.name <> EXCLUDED.name OR team.description <> EXCLUDED.description OR team.is_deleted
+			RETURNING id, external_id
+		)
+		SELECT COALESCE(u.id, e.id), i.external_id,
+			CASE
+				WHEN u.id IS NULL THEN ` + fmt.Sprint(int(Unchanged)) + `
+				WHEN e.id IS NULL OR e.is_deleted THEN ` + fmt.Sprint(int(Created)) + `
+				ELSE ` + fmt.Sprint(int(Updated)) + `
+			END
+		FROM input i
+		LEFT JOIN existing e ON e.external_id = i.external_id
+		LEFT JOIN upserted u ON u.external_id = i.external_id
+		ORDER BY i.ord`
+
+	rows, err := r.runner.QueryContext(ctx, querySql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	results := make([]UpsertResult, 0, len(teams))
+	for rows.Next() {
+		var (
+			result UpsertResult
+			status int
+		)
+		if err = rows.Scan(&result.Id, &result.ExternalId, &status); err != nil {
+			return nil, err
+		}
+		result.Status = UpsertStatus(status)
+		results = append(results, result)
+	}
+
+	return results, rows.Err()
+}
+
 // GetTeam is the method for fetching team from the database through SELECT query.
 // If query succeed it returns pointer of the fetched team and nil for error.
 // If query failed it returns nil instead of team pointer and error.
 func (r *repo) GetTeam(ctx context.Context, teamId uint64) (*models.Team, error) {
-	query := sq.Select("id", "name", "description").
+	query := sq.Select(teamColumns...).
 		From(tableName).
 		Where(sq.And{
 			sq.Eq{"id": teamId},
 			sq.Eq{"is_deleted": false},
 		}).
-		RunWith(r.db).
+		RunWith(r.runner).
 		PlaceholderFormat(sq.Dollar)
 
 	var team models.Team
-	if err := query.QueryRowContext(ctx).Scan(&team.Id, &team.Name, &team.Description); err != nil {
+	if err := query.QueryRowContext(ctx).Scan(&team.Id, &team.Name, &team.Description, &team.ExternalId); err != nil {
 		return nil, err
 	}
 
@@ -115,7 +223,7 @@ func (r *repo) CountTeams(ctx context.Context) (uint64, error) {
 	query := sq.Select("COUNT(*)").
 		From(tableName).
 		Where(sq.Eq{"is_deleted": false}).
-		RunWith(r.db).
+		RunWith(r.runner).
 		PlaceholderFormat(sq.Dollar)
 	err := query.QueryRowContext(ctx).Scan(&total)
 	if err != nil {
@@ -131,10 +239,10 @@ func (r *repo) CountTeams(ctx context.Context) (uint64, error) {
 // if no error occurred. If any error occurred through query execution, the return tuple is the
 // following: (nil, 0, error).
 func (r *repo) ListTeams(ctx context.Context, limit, offset uint64) ([]models.Team, uint64, error) {
-	query := sq.Select("id", "name", "description").
+	query := sq.Select(teamColumns...).
 		From(tableName).
 		Where(sq.Eq{"is_deleted": false}).
-		RunWith(r.db).
+		RunWith(r.runner).
 		OrderBy("id").
 		Limit(limit).
 		Offset(offset).
@@ -148,7 +256,7 @@ func (r *repo) ListTeams(ctx context.Context, limit, offset uint64) ([]models.Te
 	var teams []models.Team
 	for rows.Next() {
 		var team models.Team
-		if err := rows.Scan(&team.Id, &team.Name, &team.Description); err != nil {
+		if err := rows.Scan(&team.Id, &team.Name, &team.Description, &team.ExternalId); err != nil {
 			return nil, 0, err
 		}
 
@@ -163,6 +271,41 @@ func (r *repo) ListTeams(ctx context.Context, limit, offset uint64) ([]models.Te
 	return teams, total, nil
 }
 
+// ListTeamsAfter is the method for keyset pagination over teams ordered by id.
+// It returns at most limit teams which id is greater than afterId.
+// Unlike ListTeams it does not degrade on deep pages, so it is used
+// for streaming the whole table.
+func (r *repo) ListTeamsAfter(ctx context.Context, afterId, limit uint64) ([]models.Team, error) {
+	query := sq.Select(teamColumns...).
+		From(tableName).
+		Where(sq.And{
+			sq.Gt{"id": afterId},
+			sq.Eq{"is_deleted": false},
+		}).
+		RunWith(r.runner).
+		OrderBy("id").
+		Limit(limit).
+		PlaceholderFormat(sq.Dollar)
+
+	rows, err := query.QueryContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	teams := make([]models.Team, 0, limit)
+	for rows.Next() {
+		var team models.Team
+		if err := rows.Scan(&team.Id, &team.Name, &team.Description, &team.ExternalId); err != nil {
+			return nil, err
+		}
+
+		teams = append(teams, team)
+	}
+
+	return teams, rows.Err()
+}
+
 // RemoveTeam is the method that removes team from the database by id
 // using soft delete technique: no team actually deletes, instead
 // it is marked as deleted one.
@@ -171,7 +314,7 @@ func (r *repo) RemoveTeam(ctx context.Context, teamId uint64) error {
 	query := sq.Update(tableName).
 		Set("is_deleted", true).
 		Where(sq.Eq{"id": teamId}).
-		RunWith(r.db).
+		RunWith(r.runner).
 		PlaceholderFormat(sq.Dollar)
 
 	_, err := query.ExecContext(ctx)
@@ -179,7 +322,9 @@ func (r *repo) RemoveTeam(ctx context.Context, teamId uint64) error {
 }
 
 // UpdateTeam is the method that updates team with corresponding id
-// in the database.
+// in the database. Non-empty external id replaces the current one,
+// empty external id keeps it.
+// It returns ErrExternalIdTaken if the external id belongs to another team.
 func (r *repo) UpdateTeam(ctx context.Context, team *models.Team) error {
 	query := sq.Update(tableName).
 		Set("name", team.Name).
@@ -188,43 +333,116 @@ func (r *repo) UpdateTeam(ctx context.Context, team *models.Team) error {
 			sq.Eq{"id": team.Id},
 			sq.Eq{"is_deleted": false},
 		}).
-		RunWith(r.db).
+		RunWith(r.runner).
 		PlaceholderFormat(sq.Dollar)
 
+	if team.ExternalId != "" {
+		query = query.Set("external_id", team.ExternalId)
+	}
+
 	_, err := query.ExecContext(ctx)
+	if isExternalIdTaken(err) {
+		return ErrExternalIdTaken
+	}
 
 	return err
 }
 
 // SearchTeams is the method for Full Text Search (FTS).
-// There are 2 types of search: plaintext-oriented and phrase-oriented.
-func (r *repo) SearchTeams(ctx context.Context, query string, searchType utils.SearchType) ([]models.Team, error) {
-	var querySql string
-	switch searchType {
+// There are 4 types of search: plaintext-oriented, phrase-oriented, web search syntax
+// of websearch_to_tsquery and prefixes of the words for search-as-you-type.
+// Hits are ranked by ts_rank, matches are highlighted by ts_headline, which is computed
+// for the hits of the page only. Pages are keyset on the score and id, so deep pages
+// are as cheap as the first one. Rows are checked against ctx while being read.
+func (r *repo) SearchTeams(ctx context.Context, query SearchQuery) (*SearchResult, error) {
+	text := query.Text
+	var tsQuery string
+	switch query.Type {
 	case utils.Plain:
-		querySql = `SELECT id, ts_headline(name, q), ts_headline(description, q) FROM team, 
-			plainto_tsquery($1) AS q WHERE is_deleted = FALSE AND tsv @@ q ORDER BY ts_rank(tsv, q) DESC`
+		tsQuery = "plainto_tsquery($1)"
 	case utils.Phrase:
-		querySql = `SELECT id, ts_headline(name, q), ts_headline(description, q) FROM team, 
-			phraseto_tsquery($1) AS q WHERE is_deleted = FALSE AND tsv @@ q ORDER BY ts_rank(tsv, q) DESC`
+		tsQuery = "phraseto_tsquery($1)"
+	case utils.Websearch:
+		tsQuery = "websearch_to_tsquery($1)"
+	case utils.Prefix:
+		tsQuery = "to_tsquery($1)"
+		text = prefixTsQuery(query.Text)
 	default:
 		return nil, errors.New("incorrect search type")
 	}
 
-	rows, err := r.db.QueryContext(ctx, querySql, query)
+	cursor, err := decodePageToken(query.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if text == "" {
+		return newSearchResult(nil, query.Limit, 0), nil
+	}
+
+	matched := `FROM team, ` + tsQuery + ` AS q WHERE is_deleted = FALSE AND tsv @@ q AND ts_rank(tsv, q) >= $2`
+	args := []interface{}{text, query.MinScore}
+
+	var total uint64
+	if err = r.runner.QueryRowContext(ctx, `SELECT COUNT(*) `+matched, args...).Scan(&total); err != nil {
+		return nil, err
+	}
+	if total == 0 {
+		return newSearchResult(nil, query.Limit, 0), nil
+	}
+
+	querySql := `SELECT id, ts_headline(name, q), ts_headline(description, q), COALESCE(external_id, ''), score
+		FROM (SELECT id, name, description, external_id, q, ts_rank(tsv, q) AS score ` + matched + `) AS hits`
+	if cursor != nil {
+		querySql += ` WHERE score < $3 OR score = $3 AND id > $4`
+		args = append(args, cursor.score, cursor.id)
+	}
+	querySql += ` ORDER BY score DESC, id`
+	if limit := pageLimit(query.Limit); limit > 0 {
+		querySql += fmt.Sprintf(` LIMIT $%d`, len(args)+1)
+		args = append(args, limit)
+	}
+
+	rows, err := r.runner.QueryContext(ctx, querySql, args...)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	var teams []models.Team
-	for rows.Next() {
-		var team models.Team
-		if err = rows.Scan(&team.Id, &team.Name, &team.Description); err != nil {
-			return nil, err
-		}
-		teams = append(teams, team)
+	hits, err := scanHits(ctx, rows)
+	if err != nil {
+		return nil, err
+	}
+
+	return newSearchResult(hits, query.Limit, total), nil
+}
+
+// SuggestTeams is the method for completing names of teams which are not deleted.
+// It returns at most limit distinct names starting with prefix case-insensitively,
+// the shortest first. The prefix is matched through ix_team_name_prefix.
+func (r *repo) SuggestTeams(ctx context.Context, prefix string, limit uint64) ([]string, error) {
+	query := sq.Select("name").
+		From(tableName).
+		Where(sq.And{
+			sq.Eq{"is_deleted": false},
+			sq.Expr(`lower(name) LIKE ?`, prefixPattern(prefix)),
+		}).
+		GroupBy("name").
+		OrderBy("length(name)", "name").
+		Limit(limit).
+		RunWith(r.runner).
+		PlaceholderFormat(sq.Dollar)
+
+	return scanNames(ctx, query)
+}
+
+// prefixTsQuery is the method for making to_tsquery input matching words starting with
+// the words of query. Only letters and digits of query are kept, so the input is always valid.
+func prefixTsQuery(query string) string {
+	words := tokenize(query)
+	for i, word := range words {
+		words[i] = "'" + word + "':*"
 	}
 
-	return teams, nil
+	return strings.Join(words, " & ")
 }
@@ -0,0 +1,404 @@
+package repo
+
+import (
+	"context"
+	"github.com/ozoncp/ocp-team-api/internal/models"
+	"github.com/rs/zerolog/log"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	defaultHealthCheckInterval = 5 * time.Second
+	defaultMaxSessions         = 10000
+)
+
+type sessionKey struct{}
+
+// WithSession is the method returning the context carrying the session id of the caller.
+// Read-your-writes routing pins the session to the primary after it writes.
+func WithSession(ctx context.Context, session string) context.Context {
+	return context.WithValue(ctx, sessionKey{}, session)
+}
+
+// sessionFromContext is the method returning the session id of the caller or empty string.
+func sessionFromContext(ctx context.Context) string {
+	session, _ := ctx.Value(sessionKey{}).(string)
+	return session
+}
+
+type primaryKey struct{}
+
+// WithPrimary is the method returning the context whose reads are routed to the primary,
+// e.g. the cache misses read with WithPrimaryFill, so the lag of replicas is not cached.
+func WithPrimary(ctx context.Context) context.Context {
+	return context.WithValue(ctx, primaryKey{}, true)
+}
+
+// primaryFromContext is the method for checking whether reads must go to the primary.
+func primaryFromContext(ctx context.Context) bool {
+	primary, _ := ctx.Value(primaryKey{}).(bool)
+	return primary
+}
+
+// Replica is the struct binding the read replica to its name and health check.
+// Ping returns error when the replica must not serve reads.
+type Replica struct {
+	Name string
+	Repo Repo
+	Ping func(ctx context.Context) error
+}
+
+// replica is the state of the read replica kept by the routing repo.
+type replica struct {
+	Replica
+	healthy int32
+}
+
+func (r *replica) isHealthy() bool {
+	return atomic.LoadInt32(&r.healthy) == 1
+}
+
+func (r *replica) setHealthy(healthy bool) bool {
+	var value int32
+	if healthy {
+		value = 1
+	}
+	return atomic.SwapInt32(&r.healthy, value) != value
+}
+
+// RoutingOption is the type of optional routing settings passed to NewRoutingRepo.
+type RoutingOption func(r *routingRepo)
+
+// WithReadYourWrites is the option routing reads of the session to the primary
+// for window after the session writes, so it sees its own writes despite replication lag.
+// Session is set to the context with WithSession. By default, reads always go to replicas.
+func WithReadYourWrites(window time.Duration) RoutingOption {
+	return func(r *routingRepo) {
+		r.window = window
+	}
+}
+
+// WithMaxSessions is the option limiting the number of sessions remembered for read-your-writes.
+// When the limit is reached, the write of the new session forgets expired sessions and then,
+// if there are none, the session written the longest time ago. The default limit is 10000,
+// non-positive limit keeps the default.
+func WithMaxSessions(maxSessions int) RoutingOption {
+	return func(r *routingRepo) {
+		if maxSessions > 0 {
+			r.maxSessions = maxSessions
+		}
+	}
+}
+
+// WithHealthCheckInterval is the option setting how often Run pings the replicas.
+// The default interval is 5 seconds, non-positive interval keeps the default.
+func WithHealthCheckInterval(interval time.Duration) RoutingOption {
+	return func(r *routingRepo) {
+		if interval > 0 {
+			r.interval = interval
+		}
+	}
+}
+
+// routingRepo is the struct that implements Repo interface routing reads to
+// the replicas and writes to the primary.
+type routingRepo struct {
+	primary  Repo
+	replicas []*replica
+	next     uint32
+	interval time.Duration
+	window   time.Duration
+	now      func() time.Time
+
+	mu          sync.Mutex
+	writes      map[string]time.Time
+	maxSessions int
+}
+
+// NewRoutingRepo is the constructor method for the repo routing read methods to the healthy
+// replicas in round-robin and the rest of the methods, transactions included, to the primary.
+// Replicas are considered healthy until Run pings them. A replica failing with transient error
+// is ejected until the next successful ping and the read is repeated on the primary. Reads go
+// to the primary when no replica is healthy.
+func NewRoutingRepo(primary Repo, replicas []Replica, opts ...RoutingOption) *routingRepo {
+	r := &routingRepo{
+		primary:  primary,
+		replicas: make([]*replica, 0, len(replicas)),
+		interval: defaultHealthCheckInterval,
+		now:      time.Now,
+		writes:   make(map[string]time.Time),
+
+		maxSessions: defaultMaxSessions,
+	}
+
+	for _, rep := range replicas {
+		r.replicas = append(r.replicas, &replica{Replica: rep, healthy: 1})
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
+}
+
+// Run is the method that pings the replicas every interval until ctx is done
+// and forgets expired read-your-writes sessions.
+func (r *routingRepo) Run(ctx context.Context) error {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	r.checkHealth(ctx)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			r.checkHealth(ctx)
+			r.expireSessions()
+		}
+	}
+}
+
+// checkHealth is the method that pings every replica and updates its health.
+func (r *routingRepo) checkHealth(ctx context.Context) {
+	for _, rep := range r.replicas {
+		if rep.Ping == nil {
+			continue
+		}
+
+		pingCtx, cancel := context.WithTimeout(ctx, r.interval)
+		err := rep.Ping(pingCtx)
+		cancel()
+
+		if rep.setHealthy(err == nil) {
+			if err != nil {
+				log.Warn().Err(err).Msgf("replica %s is ejected", rep.Name)
+			} else {
+				log.Info().Msgf("replica %s is healthy again", rep.Name)
+			}
+		}
+	}
+}
+
+// expireSessions is the method that forgets sessions written longer than window ago.
+func (r *routingRepo) expireSessions() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.expireSessionsLocked(r.now())
+}
+
+// expireSessionsLocked is expireSessions for the caller holding the mutex.
+func (r *routingRepo) expireSessionsLocked(now time.Time) {
+	for session, writtenAt := range r.writes {
+		if now.Sub(writtenAt) > r.window {
+			delete(r.writes, session)
+		}
+	}
+}
+
+// evictOldestSessionLocked is the method that forgets the session written the longest time ago.
+// The caller holds the mutex.
+func (r *routingRepo) evictOldestSessionLocked() {
+	var (
+		oldest    string
+		oldestAt  time.Time
+		hasOldest bool
+	)
+	for session, writtenAt := range r.writes {
+		if !hasOldest || writtenAt.Before(oldestAt) {
+			oldest, oldestAt, hasOldest = session, writtenAt, true
+		}
+	}
+
+	if hasOldest {
+		log.Debug().Msgf("session %s is evicted from read-your-writes sessions", oldest)
+		delete(r.writes, oldest)
+	}
+}
+
+// wrote is the method that remembers the write of the session. Without replicas all
+// reads go to the primary anyway, so nothing is remembered. The number of sessions
+// is limited by maxSessions.
+func (r *routingRepo) wrote(ctx context.Context) {
+	if r.window <= 0 || len(r.replicas) == 0 {
+		return
+	}
+
+	session := sessionFromContext(ctx)
+	if session == "" {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := r.now()
+	if _, ok := r.writes[session]; !ok && len(r.writes) >= r.maxSessions {
+		r.expireSessionsLocked(now)
+		if len(r.writes) >= r.maxSessions {
+			r.evictOldestSessionLocked()
+		}
+	}
+	r.writes[session] = now
+}
+
+// pinned is the method for checking whether reads of the session must go to the primary.
+func (r *routingRepo) pinned(ctx context.Context) bool {
+	if r.window <= 0 {
+		return false
+	}
+
+	session := sessionFromContext(ctx)
+	if session == "" {
+		return false
+	}
+
+	r.mu.Lock()
+	writtenAt, ok := r.writes[session]
+	r.mu.Unlock()
+
+	return ok && r.now().Sub(writtenAt) <= r.window
+}
+
+// pick is the method returning the next healthy replica or nil.
+func (r *routingRepo) pick() *replica {
+	n := len(r.replicas)
+	if n == 0 {
+		return nil
+	}
+
+	start := atomic.AddUint32(&r.next, 1)
+	for i := 0; i < n; i++ {
+		rep := r.replicas[(int(start)+i)%n]
+		if rep.isHealthy() {
+			return rep
+		}
+	}
+
+	return nil
+}
+
+// read is the method that runs the read on the replica, falling back to the primary.
+// Reads of the pinned session and of the context made by WithPrimary go to the primary.
+func (r *routingRepo) read(ctx context.Context, fn func(repo Repo) error) error {
+	if primaryFromContext(ctx) || r.pinned(ctx) {
+		return fn(r.primary)
+	}
+
+	rep := r.pick()
+	if rep == nil {
+		return fn(r.primary)
+	}
+
+	err := fn(rep.Repo)
+	if err == nil || !IsTransient(err) || ctx.Err() != nil {
+		return err
+	}
+
+	if rep.setHealthy(false) {
+		log.Warn().Err(err).Msgf("replica %s is ejected", rep.Name)
+	}
+
+	return fn(r.primary)
+}
+
+// CreateTeam is the method that creates the team on the primary.
+func (r *routingRepo) CreateTeam(ctx context.Context, team *models.Team) error {
+	defer r.wrote(ctx)
+	return r.primary.CreateTeam(ctx, team)
+}
+
+// CreateTeams is the method that creates the teams on the primary.
+func (r *routingRepo) CreateTeams(ctx context.Context, teams []models.Team) ([]uint64, error) {
+	defer r.wrote(ctx)
+	return r.primary.CreateTeams(ctx, teams)
+}
+
+// BulkCreateTeams is the method that creates large number of teams on the primary.
+func (r *routingRepo) BulkCreateTeams(ctx context.Context, teams []models.Team) ([]uint64, error) {
+	defer r.wrote(ctx)
+	return r.primary.BulkCreateTeams(ctx, teams)
+}
+
+// UpsertTeams is the method that upserts the teams on the primary.
+func (r *routingRepo) UpsertTeams(ctx context.Context, teams []models.Team) ([]UpsertResult, error) {
+	defer r.wrote(ctx)
+	return r.primary.UpsertTeams(ctx, teams)
+}
+
+// GetTeam is the method that fetches the team from the replica.
+func (r *routingRepo) GetTeam(ctx context.Context, teamId uint64) (team *models.Team, err error) {
+	err = r.read(ctx, func(repo Repo) error {
+		team, err = repo.GetTeam(ctx, teamId)
+		return err
+	})
+	return team, err
+}
+
+// CountTeams is the method that counts the teams on the replica.
+func (r *routingRepo) CountTeams(ctx context.Context) (total uint64, err error) {
+	err = r.read(ctx, func(repo Repo) error {
+		total, err = repo.CountTeams(ctx)
+		return err
+	})
+	return total, err
+}
+
+// ListTeams is the method that fetches the page of teams from the replica.
+func (r *routingRepo) ListTeams(ctx context.Context, limit, offset uint64) (teams []models.Team, total uint64, err error) {
+	err = r.read(ctx, func(repo Repo) error {
+		teams, total, err = repo.ListTeams(ctx, limit, offset)
+		return err
+	})
+	return teams, total, err
+}
+
+// ListTeamsAfter is the method that fetches the page of teams from the replica.
+func (r *routingRepo) ListTeamsAfter(ctx context.Context, afterId, limit uint64) (teams []models.Team, err error) {
+	err = r.read(ctx, func(repo Repo) error {
+		teams, err = repo.ListTeamsAfter(ctx, afterId, limit)
+		return err
+	})
+	return teams, err
+}
+
+// RemoveTeam is the method that removes the team on the primary.
+func (r *routingRepo) RemoveTeam(ctx context.Context, teamId uint64) error {
+	defer r.wrote(ctx)
+	return r.primary.RemoveTeam(ctx, teamId)
+}
+
+// UpdateTeam is the method that updates the team on the primary.
+func (r *routingRepo) UpdateTeam(ctx context.Context, team *models.Team) error {
+	defer r.wrote(ctx)
+	return r.primary.UpdateTeam(ctx, team)
+}
+
+// SearchTeams is the method that searches the teams on the replica.
+func (r *routingRepo) SearchTeams(ctx context.Context, query SearchQuery) (result *SearchResult, err error) {
+	err = r.read(ctx, func(repo Repo) error {
+		result, err = repo.SearchTeams(ctx, query)
+		return err
+	})
+	return result, err
+}
+
+// SuggestTeams is the method that completes the names of teams on the replica.
+func (r *routingRepo) SuggestTeams(ctx context.Context, prefix string, limit uint64) (names []string, err error) {
+	err = r.read(ctx, func(repo Repo) error {
+		names, err = repo.SuggestTeams(ctx, prefix, limit)
+		return err
+	})
+	return names, err
+}
+
+// WithTx is the method that runs the transaction on the primary.
+func (r *routingRepo) WithTx(ctx context.Context, fn func(tx Repo) error, opts ...TxOption) error {
+	defer r.wrote(ctx)
+	return r.primary.WithTx(ctx, fn, opts...)
+}
@@ -0,0 +1,144 @@
+package repo_test
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/ozoncp/ocp-team-api/internal/mocks"
+	"github.com/ozoncp/ocp-team-api/internal/models"
+	"github.com/ozoncp/ocp-team-api/internal/repo"
+	"sync"
+	"time"
+)
+
+var _ = Describe("RoutingRepo", func() {
+
+	var (
+		ctrl        *gomock.Controller
+		ctx         context.Context
+		mockPrimary *mocks.MockRepo
+		mockFirst   *mocks.MockRepo
+		mockSecond  *mocks.MockRepo
+	)
+
+	team := &models.Team{Id: 1, Name: "Name"}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		ctx = context.Background()
+		mockPrimary = mocks.NewMockRepo(ctrl)
+		mockFirst = mocks.NewMockRepo(ctrl)
+		mockSecond = mocks.NewMockRepo(ctrl)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	replicas := func(pings ...func(ctx context.Context) error) []repo.Replica {
+		result := []repo.Replica{
+			{Name: "first", Repo: mockFirst},
+			{Name: "second", Repo: mockSecond},
+		}
+		for i, ping := range pings {
+			result[i].Ping = ping
+		}
+		return result
+	}
+
+	It("routes reads to replicas in round-robin and writes to the primary", func() {
+		r := repo.NewRoutingRepo(mockPrimary, replicas())
+
+		mockFirst.EXPECT().GetTeam(gomock.Any(), uint64(1)).Return(team, nil).Times(2)
+		mockSecond.EXPECT().GetTeam(gomock.Any(), uint64(1)).Return(team, nil).Times(2)
+		mockPrimary.EXPECT().CreateTeam(gomock.Any(), gomock.Any()).Return(nil)
+
+		for i := 0; i < 4; i++ {
+			Expect(r.GetTeam(ctx, 1)).Should(Equal(team))
+		}
+		Expect(r.CreateTeam(ctx, &models.Team{Name: "Name"})).Should(Succeed())
+	})
+
+	It("reads from the primary without replicas", func() {
+		r := repo.NewRoutingRepo(mockPrimary, nil)
+
+		mockPrimary.EXPECT().CountTeams(gomock.Any()).Return(uint64(3), nil)
+
+		Expect(r.CountTeams(ctx)).Should(Equal(uint64(3)))
+	})
+
+	It("ejects replica failed with transient error and repeats the read on the primary", func() {
+		r := repo.NewRoutingRepo(mockPrimary, replicas())
+
+		mockSecond.EXPECT().GetTeam(gomock.Any(), uint64(1)).Return(nil, errors.New("connection refused"))
+		mockPrimary.EXPECT().GetTeam(gomock.Any(), uint64(1)).Return(team, nil)
+		mockFirst.EXPECT().GetTeam(gomock.Any(), uint64(1)).Return(team, nil).Times(2)
+
+		for i := 0; i < 3; i++ {
+			Expect(r.GetTeam(ctx, 1)).Should(Equal(team))
+		}
+	})
+
+	It("ejects replicas failing health check", func() {
+		checked := make(chan struct{})
+		var once sync.Once
+		down := func(context.Context) error { return errors.New("down") }
+		up := func(context.Context) error {
+			once.Do(func() { close(checked) })
+			return nil
+		}
+
+		r := repo.NewRoutingRepo(mockPrimary, replicas(down, up), repo.WithHealthCheckInterval(time.Hour))
+		runCtx, cancel := context.WithCancel(ctx)
+		done := make(chan struct{})
+		go func() {
+			_ = r.Run(runCtx)
+			close(done)
+		}()
+		Eventually(checked).Should(BeClosed())
+
+		mockSecond.EXPECT().GetTeam(gomock.Any(), uint64(1)).Return(team, nil).Times(2)
+		for i := 0; i < 2; i++ {
+			Expect(r.GetTeam(ctx, 1)).Should(Equal(team))
+		}
+
+		cancel()
+		Eventually(done).Should(BeClosed())
+	})
+
+	It("pins the session to the primary after its write", func() {
+		r := repo.NewRoutingRepo(mockPrimary, replicas(), repo.WithReadYourWrites(time.Hour))
+		session := repo.WithSession(ctx, "session")
+
+		mockPrimary.EXPECT().UpdateTeam(gomock.Any(), gomock.Any()).Return(nil)
+		mockPrimary.EXPECT().GetTeam(gomock.Any(), uint64(1)).Return(team, nil)
+		mockFirst.EXPECT().GetTeam(gomock.Any(), uint64(1)).Return(team, nil).AnyTimes()
+		mockSecond.EXPECT().GetTeam(gomock.Any(), uint64(1)).Return(team, nil).AnyTimes()
+
+		Expect(r.UpdateTeam(session, team)).Should(Succeed())
+		Expect(r.GetTeam(session, 1)).Should(Equal(team))
+		Expect(r.GetTeam(repo.WithSession(ctx, "other"), 1)).Should(Equal(team))
+	})
+
+	It("forgets the session written the longest time ago over max sessions", func() {
+		r := repo.NewRoutingRepo(
+			mockPrimary,
+			[]repo.Replica{{Name: "first", Repo: mockFirst}},
+			repo.WithReadYourWrites(time.Hour),
+			repo.WithMaxSessions(1),
+		)
+		first := repo.WithSession(ctx, "first")
+		second := repo.WithSession(ctx, "second")
+
+		mockPrimary.EXPECT().UpdateTeam(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+		mockPrimary.EXPECT().GetTeam(gomock.Any(), uint64(1)).Return(team, nil).Times(1)
+		mockFirst.EXPECT().GetTeam(gomock.Any(), uint64(1)).Return(team, nil).Times(1)
+
+		Expect(r.UpdateTeam(first, team)).Should(Succeed())
+		Expect(r.UpdateTeam(second, team)).Should(Succeed())
+		Expect(r.GetTeam(first, 1)).Should(Equal(team))
+		Expect(r.GetTeam(second, 1)).Should(Equal(team))
+	})
+})
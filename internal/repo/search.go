@@ -0,0 +1,232 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"errors"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/ozoncp/ocp-team-api/internal/models"
+	"github.com/ozoncp/ocp-team-api/internal/utils"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// ErrInvalidPageToken is returned by SearchTeams when the page token is malformed.
+var ErrInvalidPageToken = errors.New("invalid page token")
+
+// SearchQuery is the struct representing parameters of Full Text Search (FTS).
+// Hits are ordered by score from the highest and then by id. Zero Limit means no limit,
+// PageToken is NextPageToken of the previous page. Hits scored below MinScore are skipped.
+type SearchQuery struct {
+	Text      string
+	Type      utils.SearchType
+	Limit     uint64
+	PageToken string
+	MinScore  float64
+}
+
+// SearchHit is the struct representing the team found by the search together with its score.
+type SearchHit struct {
+	Team  models.Team
+	Score float64
+}
+
+// SearchResult is the struct representing the page of hits.
+// Total is the number of hits of all pages, NextPageToken is empty on the last page.
+type SearchResult struct {
+	Hits          []SearchHit
+	Total         uint64
+	NextPageToken string
+}
+
+// searchCursor is the position of the last hit of the page, the next page starts after it.
+type searchCursor struct {
+	score float64
+	id    uint64
+}
+
+// after is the method reporting whether the hit goes after the cursor in the order of hits.
+func (c *searchCursor) after(hit SearchHit) bool {
+	return hit.Score < c.score || hit.Score == c.score && hit.Team.Id > c.id
+}
+
+// encodePageToken is the method for making the opaque token of the page after hit.
+func encodePageToken(hit SearchHit) string {
+	raw := strconv.FormatFloat(hit.Score, 'g', -1, 64) + ":" + strconv.FormatUint(hit.Team.Id, 10)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// decodePageToken is the method for parsing the token made by encodePageToken.
+// It returns nil cursor for the empty token.
+func decodePageToken(token string) (*searchCursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidPageToken
+	}
+
+	parts := strings.SplitN(string(raw), ":", 2)
+	if len(parts) != 2 {
+		return nil, ErrInvalidPageToken
+	}
+
+	score, err := strconv.ParseFloat(parts[0], 64)
+	if err != nil {
+		return nil, ErrInvalidPageToken
+	}
+
+	id, err := strconv.ParseUint(parts[1], 10, 64)
+	if err != nil {
+		return nil, ErrInvalidPageToken
+	}
+
+	return &searchCursor{score: score, id: id}, nil
+}
+
+// pageLimit is the method returning the number of hits to fetch for the page:
+// one more than the limit shows whether the next page exists.
+func pageLimit(limit uint64) uint64 {
+	if limit == 0 {
+		return 0
+	}
+	return limit + 1
+}
+
+// newSearchResult is the method for making the page of hits fetched with pageLimit.
+func newSearchResult(hits []SearchHit, limit, total uint64) *SearchResult {
+	result := &SearchResult{Hits: hits, Total: total}
+	if result.Hits == nil {
+		result.Hits = []SearchHit{}
+	}
+
+	if limit > 0 && uint64(len(hits)) > limit {
+		result.Hits = hits[:limit]
+		result.NextPageToken = encodePageToken(hits[limit-1])
+	}
+
+	return result
+}
+
+// scanHits is the method for reading rows of id, name, description, external id and score.
+// It stops once ctx is done, so the large result is not read in vain.
+func scanHits(ctx context.Context, rows *sql.Rows) ([]SearchHit, error) {
+	var hits []SearchHit
+	for rows.Next() {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		var hit SearchHit
+		team := &hit.Team
+		if err := rows.Scan(&team.Id, &team.Name, &team.Description, &team.ExternalId, &hit.Score); err != nil {
+			return nil, err
+		}
+		hits = append(hits, hit)
+	}
+
+	return hits, rows.Err()
+}
+
+// webTerm is the term of the web search query: the word or the quoted phrase, possibly negated.
+type webTerm struct {
+	words   []string
+	negated bool
+}
+
+// parseWebsearch is the method for parsing the query the way websearch_to_tsquery does:
+// text in quotes is the phrase, OR separates alternatives and the leading minus excludes
+// the term. It returns alternatives, each of them is the list of terms matched together.
+// Alternatives without terms to match, e.g. of excluded words only, are dropped.
+func parseWebsearch(query string) [][]webTerm {
+	var (
+		groups  [][]webTerm
+		group   []webTerm
+		matches bool
+	)
+	closeGroup := func() {
+		if matches {
+			groups = append(groups, group)
+		}
+		group, matches = nil, false
+	}
+
+	runes := []rune(query)
+	for i := 0; i < len(runes); {
+		if unicode.IsSpace(runes[i]) {
+			i++
+			continue
+		}
+
+		negated := runes[i] == '-'
+		if negated {
+			i++
+		}
+
+		var text string
+		quoted := i < len(runes) && runes[i] == '"'
+		if quoted {
+			end := i + 1
+			for end < len(runes) && runes[end] != '"' {
+				end++
+			}
+			text = string(runes[i+1 : end])
+			i = end + 1
+		} else {
+			end := i
+			for end < len(runes) && !unicode.IsSpace(runes[end]) && runes[end] != '"' {
+				end++
+			}
+			text = string(runes[i:end])
+			i = end
+		}
+
+		if !quoted && !negated && strings.EqualFold(text, "or") {
+			closeGroup()
+			continue
+		}
+
+		words := tokenize(text)
+		if len(words) == 0 {
+			continue
+		}
+
+		group = append(group, webTerm{words: words, negated: negated})
+		matches = matches || !negated
+	}
+	closeGroup()
+
+	return groups
+}
+
+// likeEscaper escapes wildcards of LIKE patterns, backslash is the escape character.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// prefixPattern is the method for making the LIKE pattern matching text starting with prefix.
+func prefixPattern(prefix string) string {
+	return likeEscaper.Replace(strings.ToLower(prefix)) + "%"
+}
+
+// scanNames is the method for running the query of names of SuggestTeams.
+func scanNames(ctx context.Context, query sq.SelectBuilder) ([]string, error) {
+	rows, err := query.QueryContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	names := []string{}
+	for rows.Next() {
+		var name string
+		if err = rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		names = append(names, name)
+	}
+
+	return names, rows.Err()
+}
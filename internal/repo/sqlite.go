@@ -0,0 +1,464 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+	"github.com/ozoncp/ocp-team-api/internal/models"
+	"github.com/ozoncp/ocp-team-api/internal/utils"
+	"github.com/rs/zerolog/log"
+	"strings"
+)
+
+// NewSQLiteRepo is the constructor method for sqliteRepo struct.
+// The database must be opened with the sqlite3 driver built with FTS5
+// (the sqlite_fts5 build tag) and migrated with the sqlite migrations.
+func NewSQLiteRepo(db *sqlx.DB) *sqliteRepo {
+	return &sqliteRepo{db: db, runner: traced(db)}
+}
+
+// sqliteRepo is the struct that implements Repo interface on SQLite.
+// Soft delete works the same way as in repo, the search is done with the FTS5
+// table team_fts, which is kept in sync with team by triggers.
+type sqliteRepo struct {
+	db     *sqlx.DB
+	runner sq.StdSqlCtx
+	inTx   bool
+}
+
+// CreateTeam is the method for creating new team through SQL INSERT.
+// It returns error if INSERT query failed.
+func (r *sqliteRepo) CreateTeam(ctx context.Context, team *models.Team) error {
+	query := sq.Insert(tableName).
+		Columns("name", "description").
+		Values(team.Name, team.Description).
+		RunWith(r.runner).
+		PlaceholderFormat(sq.Question)
+
+	result, err := query.ExecContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	team.Id = uint64(id)
+
+	return nil
+}
+
+// CreateTeams is the method for creating multiple teams in the single transaction.
+// It returns ids of created teams in the order of teams. External ids are ignored,
+// they are set by UpsertTeams only.
+// It returns error if any INSERT query failed, no team is created then.
+func (r *sqliteRepo) CreateTeams(ctx context.Context, teams []models.Team) ([]uint64, error) {
+	ids := make([]uint64, 0, len(teams))
+
+	err := r.WithTx(ctx, func(tx Repo) error {
+		ids = ids[:0]
+		for _, team := range teams {
+			if err := tx.CreateTeam(ctx, &team); err != nil {
+				return err
+			}
+			ids = append(ids, team.Id)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+// BulkCreateTeams is the method for creating large number of teams.
+// SQLite has no COPY, so it is the same as CreateTeams.
+func (r *sqliteRepo) BulkCreateTeams(ctx context.Context, teams []models.Team) ([]uint64, error) {
+	return r.CreateTeams(ctx, teams)
+}
+
+// UpsertTeams is the method for creating or updating multiple teams keyed by external id
+// in the single transaction. Soft deleted teams are restored. Rows with the same
+// data are not touched. External ids must be unique within the call.
+// It returns results in the order of teams.
+// It returns error if any query failed.
+func (r *sqliteRepo) UpsertTeams(ctx context.Context, teams []models.Team) ([]UpsertResult, error) {
+	results := make([]UpsertResult, 0, len(teams))
+
+	err := r.WithTx(ctx, func(tx Repo) error {
+		results = results[:0]
+		for _, team := range teams {
+			result, err := tx.(*sqliteRepo).upsertTeam(ctx, team)
+			if err != nil {
+				return err
+			}
+			results = append(results, result)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// upsertTeam is the method for upserting the single team, it must be run in the transaction.
+func (r *sqliteRepo) upsertTeam(ctx context.Context, team models.Team) (UpsertResult, error) {
+	result := UpsertResult{ExternalId: team.ExternalId}
+
+	var existing struct {
+		name, description string
+		isDeleted         bool
+	}
+	err := sq.Select("id", "name", "description", "is_deleted").
+		From(tableName).
+		Where(sq.Eq{"external_id": team.ExternalId}).
+		RunWith(r.runner).
+		PlaceholderFormat(sq.Question).
+		QueryRowContext(ctx).
+		Scan(&result.Id, &existing.name, &existing.description, &existing.isDeleted)
+
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		query := sq.Insert(tableName).
+			Columns("external_id", "name", "description").
+			Values(team.ExternalId, team.Name, team.Description).
+			RunWith(r.runner).
+			PlaceholderFormat(sq.Question)
+
+		res, err := query.ExecContext(ctx)
+		if err != nil {
+			return result, err
+		}
+		id, err := res.LastInsertId()
+		if err != nil {
+			return result, err
+		}
+		result.Id, result.Status = uint64(id), Created
+		return result, nil
+	case err != nil:
+		return result, err
+	case !existing.isDeleted && existing.name == team.Name && existing.description == team.Description:
+		result.Status = Unchanged
+		return result, nil
+	}
+
+	query := sq.Update(tableName).
+		Set("name", team.Name).
+		Set("description", team.Description).
+		Set("is_deleted", false).
+		Where(sq.Eq{"id": result.Id}).
+		RunWith(r.runner).
+		PlaceholderFormat(sq.Question)
+
+	if _, err = query.ExecContext(ctx); err != nil {
+		return result, err
+	}
+
+	result.Status = Updated
+	if existing.isDeleted {
+		result.Status = Created
+	}
+
+	return result, nil
+}
+
+// GetTeam is the method for fetching team from the database through SELECT query.
+// It returns sql.ErrNoRows if the team does not exist or is deleted.
+func (r *sqliteRepo) GetTeam(ctx context.Context, teamId uint64) (*models.Team, error) {
+	query := sq.Select(teamColumns...).
+		From(tableName).
+		Where(sq.And{
+			sq.Eq{"id": teamId},
+			sq.Eq{"is_deleted": false},
+		}).
+		RunWith(r.runner).
+		PlaceholderFormat(sq.Question)
+
+	var team models.Team
+	if err := query.QueryRowContext(ctx).Scan(&team.Id, &team.Name, &team.Description, &team.ExternalId); err != nil {
+		return nil, err
+	}
+
+	return &team, nil
+}
+
+// CountTeams is the method for retrieving the amount of teams which are not deleted.
+func (r *sqliteRepo) CountTeams(ctx context.Context) (uint64, error) {
+	var total uint64
+	query := sq.Select("COUNT(*)").
+		From(tableName).
+		Where(sq.Eq{"is_deleted": false}).
+		RunWith(r.runner).
+		PlaceholderFormat(sq.Question)
+
+	if err := query.QueryRowContext(ctx).Scan(&total); err != nil {
+		return 0, err
+	}
+
+	return total, nil
+}
+
+// ListTeams is the method for retrieving the page of teams ordered by id
+// together with the amount of teams.
+func (r *sqliteRepo) ListTeams(ctx context.Context, limit, offset uint64) ([]models.Team, uint64, error) {
+	query := sq.Select(teamColumns...).
+		From(tableName).
+		Where(sq.Eq{"is_deleted": false}).
+		OrderBy("id").
+		Limit(limit).
+		Offset(offset)
+
+	teams, err := r.queryTeams(ctx, query)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total, err := r.CountTeams(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return teams, total, nil
+}
+
+// ListTeamsAfter is the method for keyset pagination over teams ordered by id.
+// It returns at most limit teams which id is greater than afterId.
+func (r *sqliteRepo) ListTeamsAfter(ctx context.Context, afterId, limit uint64) ([]models.Team, error) {
+	query := sq.Select(teamColumns...).
+		From(tableName).
+		Where(sq.And{
+			sq.Gt{"id": afterId},
+			sq.Eq{"is_deleted": false},
+		}).
+		OrderBy("id").
+		Limit(limit)
+
+	teams, err := r.queryTeams(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	if teams == nil {
+		teams = []models.Team{}
+	}
+
+	return teams, nil
+}
+
+// RemoveTeam is the method that marks the team as deleted.
+func (r *sqliteRepo) RemoveTeam(ctx context.Context, teamId uint64) error {
+	query := sq.Update(tableName).
+		Set("is_deleted", true).
+		Where(sq.Eq{"id": teamId}).
+		RunWith(r.runner).
+		PlaceholderFormat(sq.Question)
+
+	_, err := query.ExecContext(ctx)
+	return err
+}
+
+// UpdateTeam is the method that updates name and description of the team which is not deleted.
+// Non-empty external id replaces the current one, empty external id keeps it.
+// It returns ErrExternalIdTaken if the external id belongs to another team.
+func (r *sqliteRepo) UpdateTeam(ctx context.Context, team *models.Team) error {
+	query := sq.Update(tableName).
+		Set("name", team.Name).
+		Set("description", team.Description).
+		Where(sq.And{
+			sq.Eq{"id": team.Id},
+			sq.Eq{"is_deleted": false},
+		}).
+		RunWith(r.runner).
+		PlaceholderFormat(sq.Question)
+
+	if team.ExternalId != "" {
+		query = query.Set("external_id", team.ExternalId)
+	}
+
+	// The driver is not imported by the repo, so the violation is recognized by its message.
+	_, err := query.ExecContext(ctx)
+	if err != nil && strings.Contains(err.Error(), "UNIQUE constraint failed: team.external_id") {
+		return ErrExternalIdTaken
+	}
+
+	return err
+}
+
+// SearchTeams is the method for Full Text Search (FTS) through FTS5.
+// Plain search matches teams containing all words of the query, phrase search
+// matches teams containing the words one after another, web search and prefix search
+// are translated to FTS5 queries with the same meaning as in repo. Teams are ordered by
+// bm25 rank with name weighted over description, matches are wrapped in <b> tags.
+// The score is the negated bm25 rank, so the better hits have the higher score as in repo.
+// Prefixes are stemmed by the porter tokenizer as well, so the prefix changed by stemming,
+// e.g. "pay" stemmed to "pai", does not match the words it starts.
+func (r *sqliteRepo) SearchTeams(ctx context.Context, query SearchQuery) (*SearchResult, error) {
+	var match string
+	switch query.Type {
+	case utils.Plain:
+		match = strings.Join(quoteWords(tokenize(query.Text)), " AND ")
+	case utils.Phrase:
+		match = strings.Join(quoteWords(tokenize(query.Text)), " + ")
+	case utils.Websearch:
+		match = websearchMatch(parseWebsearch(query.Text))
+	case utils.Prefix:
+		words := quoteWords(tokenize(query.Text))
+		for i, word := range words {
+			words[i] = word + "*"
+		}
+		match = strings.Join(words, " AND ")
+	default:
+		return nil, errors.New("incorrect search type")
+	}
+
+	cursor, err := decodePageToken(query.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if match == "" {
+		return newSearchResult(nil, query.Limit, 0), nil
+	}
+
+	matched := `FROM (SELECT t.id, COALESCE(t.external_id, '') AS external_id, -bm25(team_fts, 1.0, 0.4) AS score,
+			highlight(team_fts, 0, '<b>', '</b>') AS name, highlight(team_fts, 1, '<b>', '</b>') AS description
+			FROM team_fts JOIN team t ON t.id = team_fts.rowid
+			WHERE team_fts MATCH ? AND t.is_deleted = FALSE) WHERE score >= ?`
+	args := []interface{}{match, query.MinScore}
+
+	var total uint64
+	if err = r.runner.QueryRowContext(ctx, `SELECT COUNT(*) `+matched, args...).Scan(&total); err != nil {
+		return nil, err
+	}
+	if total == 0 {
+		return newSearchResult(nil, query.Limit, 0), nil
+	}
+
+	querySql := `SELECT id, name, description, external_id, score ` + matched
+	if cursor != nil {
+		querySql += ` AND (score < ? OR score = ? AND id > ?)`
+		args = append(args, cursor.score, cursor.score, cursor.id)
+	}
+	querySql += ` ORDER BY score DESC, id`
+	if limit := pageLimit(query.Limit); limit > 0 {
+		querySql += ` LIMIT ?`
+		args = append(args, limit)
+	}
+
+	rows, err := r.runner.QueryContext(ctx, querySql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	hits, err := scanHits(ctx, rows)
+	if err != nil {
+		return nil, err
+	}
+
+	return newSearchResult(hits, query.Limit, total), nil
+}
+
+// SuggestTeams is the method for completing names of teams which are not deleted.
+// It returns at most limit distinct names starting with prefix, LIKE of SQLite
+// is case-insensitive for ASCII letters only.
+func (r *sqliteRepo) SuggestTeams(ctx context.Context, prefix string, limit uint64) ([]string, error) {
+	query := sq.Select("name").
+		From(tableName).
+		Where(sq.And{
+			sq.Eq{"is_deleted": false},
+			sq.Expr(`name LIKE ? ESCAPE '\'`, prefixPattern(prefix)),
+		}).
+		GroupBy("name").
+		OrderBy("length(name)", "name").
+		Limit(limit).
+		RunWith(r.runner).
+		PlaceholderFormat(sq.Question)
+
+	return scanNames(ctx, query)
+}
+
+// quoteWords is the method for quoting the words as FTS5 strings, so they are never operators.
+func quoteWords(words []string) []string {
+	quoted := make([]string, len(words))
+	for i, word := range words {
+		quoted[i] = `"` + word + `"`
+	}
+	return quoted
+}
+
+// websearchMatch is the method for making FTS5 query of the web search alternatives.
+// FTS5 NOT is binary, so excluded terms follow the terms to match.
+func websearchMatch(groups [][]webTerm) string {
+	alternatives := make([]string, 0, len(groups))
+	for _, group := range groups {
+		var included, excluded []string
+		for _, term := range group {
+			phrase := strings.Join(quoteWords(term.words), " + ")
+			if term.negated {
+				excluded = append(excluded, " NOT "+phrase)
+			} else {
+				included = append(included, phrase)
+			}
+		}
+		alternatives = append(alternatives, "("+strings.Join(included, " AND ")+strings.Join(excluded, "")+")")
+	}
+
+	return strings.Join(alternatives, " OR ")
+}
+
+// WithTx is the method for running fn in the transaction as the unit of work.
+// SQLite transactions are always serializable, so opts are ignored. Nested calls
+// join the outer transaction.
+func (r *sqliteRepo) WithTx(ctx context.Context, fn func(tx Repo) error, opts ...TxOption) (err error) {
+	if r.inTx {
+		return fn(r)
+	}
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		}
+	}()
+
+	if err = fn(&sqliteRepo{db: r.db, runner: traced(tx), inTx: true}); err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			log.Error().Err(rollbackErr).Msg("cannot rollback transaction")
+		}
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// queryTeams is the method for running SELECT of teamColumns.
+func (r *sqliteRepo) queryTeams(ctx context.Context, query sq.SelectBuilder) ([]models.Team, error) {
+	rows, err := query.RunWith(r.runner).PlaceholderFormat(sq.Question).QueryContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var teams []models.Team
+	for rows.Next() {
+		var team models.Team
+		if err := rows.Scan(&team.Id, &team.Name, &team.Description, &team.ExternalId); err != nil {
+			return nil, err
+		}
+
+		teams = append(teams, team)
+	}
+
+	return teams, rows.Err()
+}
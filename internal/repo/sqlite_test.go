@@ -0,0 +1,72 @@
+//go:build sqlite_fts5
+// +build sqlite_fts5
+
+package repo_test
+
+import (
+	"context"
+	"database/sql"
+	"github.com/jmoiron/sqlx"
+	_ "github.com/mattn/go-sqlite3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/mocktracer"
+	"github.com/ozoncp/ocp-team-api/internal/repo"
+	"github.com/ozoncp/ocp-team-api/migrations"
+	"github.com/pressly/goose/v3"
+	"io/ioutil"
+	"log"
+)
+
+var _ = Describe("SQLiteRepo", func() {
+
+	var (
+		ctx context.Context
+		db  *sqlx.DB
+		r   repo.Repo
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		var err error
+		db, err = sqlx.Connect("sqlite3", ":memory:")
+		Expect(err).Should(BeNil())
+		db.SetMaxOpenConns(1)
+
+		goose.SetLogger(log.New(ioutil.Discard, "", 0))
+		Expect(goose.SetDialect("sqlite3")).Should(Succeed())
+		goose.SetBaseFS(migrations.FS)
+		Expect(goose.Up(db.DB, "sqlite")).Should(Succeed())
+
+		r = repo.NewSQLiteRepo(db)
+	})
+
+	AfterEach(func() {
+		db.Close()
+	})
+
+	repoConformance(func() repo.Repo {
+		return r
+	})
+
+	It("traces statements with sanitized SQL", func() {
+		tracer := mocktracer.New()
+		opentracing.SetGlobalTracer(tracer)
+		defer opentracing.SetGlobalTracer(opentracing.NoopTracer{})
+
+		parent := tracer.StartSpan("GetTeamV1")
+		_, err := r.GetTeam(opentracing.ContextWithSpan(ctx, parent), 1)
+		Expect(err).Should(MatchError(sql.ErrNoRows))
+
+		spans := tracer.FinishedSpans()
+		Expect(spans).Should(HaveLen(1))
+		Expect(spans[0].OperationName).Should(Equal("sql"))
+		Expect(spans[0].ParentID).Should(Equal(parent.Context().(mocktracer.MockSpanContext).SpanID))
+		Expect(spans[0].Tag("db.statement")).Should(Equal(
+			"SELECT id, name, description, COALESCE(external_id, ?) FROM team WHERE (id = ? AND is_deleted = ?)",
+		))
+		Expect(spans[0].Tag("error")).Should(BeNil())
+	})
+})
@@ -0,0 +1,84 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/ext"
+	"regexp"
+	"strings"
+)
+
+var (
+	sqlStringLiteral  = regexp.MustCompile(`'(?:[^']|'')*'`)
+	sqlNumericLiteral = regexp.MustCompile(`(^|[^\w$])\d+(?:\.\d+)?`)
+)
+
+// sanitizeSQL is the method for making the statement safe and compact for tracing:
+// literals are replaced with ? and whitespace is collapsed. Arguments are never traced.
+func sanitizeSQL(query string) string {
+	query = sqlStringLiteral.ReplaceAllString(query, "?")
+	query = sqlNumericLiteral.ReplaceAllString(query, "${1}?")
+	return strings.Join(strings.Fields(query), " ")
+}
+
+// tracedRunner is the struct that implements sq.StdSqlCtx starting the span
+// for every statement run with the context. The span is the child of the span
+// of the context and carries the sanitized statement.
+type tracedRunner struct {
+	sq.StdSqlCtx
+}
+
+// traced is the method wrapping runner with tracedRunner.
+func traced(runner sq.StdSqlCtx) sq.StdSqlCtx {
+	return &tracedRunner{StdSqlCtx: runner}
+}
+
+// startSpan is the method starting the span of the statement as the child of the span of ctx.
+func startSpan(ctx context.Context, query string) opentracing.Span {
+	span, _ := opentracing.StartSpanFromContext(ctx, "sql")
+	ext.DBType.Set(span, "sql")
+	ext.DBStatement.Set(span, sanitizeSQL(query))
+	return span
+}
+
+func finishSpan(span opentracing.Span, err error) {
+	if err != nil && err != sql.ErrNoRows {
+		ext.LogError(span, err)
+	}
+	span.Finish()
+}
+
+// traceStatement is the method running the statement in the span as tracedRunner does,
+// it is used for statements run directly on the driver connection, e.g. COPY.
+func traceStatement(ctx context.Context, query string, fn func() error) error {
+	span := startSpan(ctx, query)
+	err := fn()
+	finishSpan(span, err)
+	return err
+}
+
+// QueryContext is the method running the query in the span.
+func (r *tracedRunner) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	span := startSpan(ctx, query)
+	rows, err := r.StdSqlCtx.QueryContext(ctx, query, args...)
+	finishSpan(span, err)
+	return rows, err
+}
+
+// QueryRowContext is the method running the query of the single row in the span.
+func (r *tracedRunner) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	span := startSpan(ctx, query)
+	row := r.StdSqlCtx.QueryRowContext(ctx, query, args...)
+	finishSpan(span, row.Err())
+	return row
+}
+
+// ExecContext is the method running the statement in the span.
+func (r *tracedRunner) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	span := startSpan(ctx, query)
+	result, err := r.StdSqlCtx.ExecContext(ctx, query, args...)
+	finishSpan(span, err)
+	return result, err
+}
@@ -0,0 +1,108 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"github.com/ozoncp/ocp-team-api/internal/utils"
+	"github.com/rs/zerolog/log"
+	"time"
+)
+
+const (
+	defaultTxAttempts = 3
+	txInitialBackoff  = 10 * time.Millisecond
+	txMaxBackoff      = 500 * time.Millisecond
+)
+
+// TxOption is the type of optional transaction settings passed to WithTx.
+type TxOption func(o *txOptions)
+
+type txOptions struct {
+	isolation   sql.IsolationLevel
+	readOnly    bool
+	maxAttempts int
+}
+
+// WithIsolation is the option setting the isolation level of the transaction.
+// By default, the isolation level of the database is used.
+func WithIsolation(level sql.IsolationLevel) TxOption {
+	return func(o *txOptions) {
+		o.isolation = level
+	}
+}
+
+// ReadOnly is the option starting read-only transaction.
+func ReadOnly() TxOption {
+	return func(o *txOptions) {
+		o.readOnly = true
+	}
+}
+
+// WithTxAttempts is the option setting how many times the transaction is run
+// when it fails with serialization failure or deadlock. The default is 3.
+func WithTxAttempts(maxAttempts int) TxOption {
+	return func(o *txOptions) {
+		o.maxAttempts = maxAttempts
+	}
+}
+
+// WithTx is the method for running fn in the transaction as the unit of work.
+// The Repo passed to fn runs its statements in the transaction, which is committed
+// if fn returns nil and rolled back otherwise. On serialization failure or deadlock
+// the whole transaction including fn is repeated, so fn must not have side effects
+// besides the statements. Nested calls join the outer transaction ignoring opts.
+func (r *repo) WithTx(ctx context.Context, fn func(tx Repo) error, opts ...TxOption) error {
+	if r.inTx {
+		return fn(r)
+	}
+
+	o := txOptions{maxAttempts: defaultTxAttempts}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if o.maxAttempts < 1 {
+		o.maxAttempts = 1
+	}
+
+	backoff := txInitialBackoff
+	for attempt := 1; ; attempt++ {
+		err := r.runTx(ctx, fn, &sql.TxOptions{Isolation: o.isolation, ReadOnly: o.readOnly})
+		if err == nil || !IsSerializationFailure(err) || attempt >= o.maxAttempts {
+			return err
+		}
+
+		log.Debug().Err(err).Msgf("transaction attempt %d failed, retrying", attempt)
+		if !utils.Sleep(ctx, utils.Jitter(backoff)) {
+			return err
+		}
+
+		if backoff *= 2; backoff > txMaxBackoff {
+			backoff = txMaxBackoff
+		}
+	}
+}
+
+// runTx is the method that runs fn in the single transaction.
+// The transaction is rolled back if fn fails or panics.
+func (r *repo) runTx(ctx context.Context, fn func(tx Repo) error, opts *sql.TxOptions) (err error) {
+	tx, err := r.db.BeginTxx(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		}
+	}()
+
+	if err = fn(&repo{db: r.db, runner: traced(tx), inTx: true}); err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			log.Error().Err(rollbackErr).Msg("cannot rollback transaction")
+		}
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,221 @@
+package repo_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jackc/pgconn"
+	"github.com/jmoiron/sqlx"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/ozoncp/ocp-team-api/internal/models"
+	"github.com/ozoncp/ocp-team-api/internal/repo"
+)
+
+// mockConn is the set of interfaces the connection of sqlmock implements.
+type mockConn interface {
+	driver.Conn
+	driver.ConnBeginTx
+	driver.ConnPrepareContext
+	driver.ExecerContext
+	driver.QueryerContext
+}
+
+// txOptionsConn is the connection recording options of the transactions it begins,
+// sqlmock itself ignores them.
+type txOptionsConn struct {
+	mockConn
+	opts *[]driver.TxOptions
+}
+
+func (c txOptionsConn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	*c.opts = append(*c.opts, opts)
+	return c.mockConn.BeginTx(ctx, opts)
+}
+
+// txOptionsConnector is the connector of sqlmock connections recording options of transactions.
+type txOptionsConnector struct {
+	dsn    string
+	driver driver.Driver
+	opts   *[]driver.TxOptions
+}
+
+func (c txOptionsConnector) Connect(context.Context) (driver.Conn, error) {
+	conn, err := c.driver.Open(c.dsn)
+	if err != nil {
+		return nil, err
+	}
+	return txOptionsConn{mockConn: conn.(mockConn), opts: c.opts}, nil
+}
+
+func (c txOptionsConnector) Driver() driver.Driver {
+	return c.driver
+}
+
+var _ = Describe("Repo WithTx", func() {
+
+	var (
+		ctx    context.Context
+		mockDb *sql.DB
+		db     *sql.DB
+		mock   sqlmock.Sqlmock
+		opts   []driver.TxOptions
+		r      repo.Repo
+		noop   func(tx repo.Repo) error
+		failed int
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		var err error
+		mockDb, mock, err = sqlmock.NewWithDSN("tx_test")
+		Expect(err).Should(BeNil())
+
+		opts = nil
+		db = sql.OpenDB(txOptionsConnector{dsn: "tx_test", driver: mockDb.Driver(), opts: &opts})
+
+		r = repo.NewRepo(sqlx.NewDb(db, "pgx"))
+		noop = func(repo.Repo) error { return nil }
+		failed = 0
+	})
+
+	AfterEach(func() {
+		Expect(mock.ExpectationsWereMet()).Should(Succeed())
+		_ = db.Close()
+		_ = mockDb.Close()
+	})
+
+	It("runs statements in the transaction and commits it", func() {
+		mock.ExpectBegin()
+		mock.ExpectExec("UPDATE team SET is_deleted").WithArgs(true, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		err := r.WithTx(ctx, func(tx repo.Repo) error {
+			return tx.RemoveTeam(ctx, 1)
+		})
+		Expect(err).Should(BeNil())
+	})
+
+	It("rolls back the transaction when fn fails", func() {
+		mock.ExpectBegin()
+		mock.ExpectRollback()
+
+		err := r.WithTx(ctx, func(repo.Repo) error {
+			return errors.New("invalid")
+		})
+		Expect(err).Should(MatchError("invalid"))
+	})
+
+	It("rolls back the transaction when fn panics", func() {
+		mock.ExpectBegin()
+		mock.ExpectRollback()
+
+		Expect(func() {
+			_ = r.WithTx(ctx, func(repo.Repo) error {
+				panic("invalid")
+			})
+		}).Should(PanicWith("invalid"))
+	})
+
+	for code, reason := range map[string]string{"40001": "serialization failure", "40P01": "deadlock"} {
+		code := code
+
+		It("repeats the transaction failed due to "+reason, func() {
+			mock.ExpectBegin()
+			mock.ExpectRollback()
+			mock.ExpectBegin()
+			mock.ExpectCommit()
+
+			err := r.WithTx(ctx, func(repo.Repo) error {
+				if failed++; failed == 1 {
+					return &pgconn.PgError{Code: code}
+				}
+				return nil
+			})
+			Expect(err).Should(BeNil())
+			Expect(failed).Should(Equal(2))
+		})
+	}
+
+	It("does not repeat the transaction failed with other errors", func() {
+		mock.ExpectBegin()
+		mock.ExpectRollback()
+
+		err := r.WithTx(ctx, func(repo.Repo) error {
+			failed++
+			return &pgconn.PgError{Code: "23505"}
+		})
+		Expect(repo.IsSerializationFailure(err)).Should(BeFalse())
+		Expect(failed).Should(Equal(1))
+	})
+
+	It("gives up after max attempts", func() {
+		for i := 0; i < 2; i++ {
+			mock.ExpectBegin()
+			mock.ExpectRollback()
+		}
+
+		err := r.WithTx(ctx, func(repo.Repo) error {
+			failed++
+			return &pgconn.PgError{Code: "40001"}
+		}, repo.WithTxAttempts(2))
+		Expect(repo.IsSerializationFailure(err)).Should(BeTrue())
+		Expect(failed).Should(Equal(2))
+	})
+
+	It("begins the transaction with isolation level and read-only mode", func() {
+		mock.ExpectBegin()
+		mock.ExpectCommit()
+
+		err := r.WithTx(ctx, noop, repo.WithIsolation(sql.LevelSerializable), repo.ReadOnly())
+		Expect(err).Should(BeNil())
+		Expect(opts).Should(Equal([]driver.TxOptions{
+			{Isolation: driver.IsolationLevel(sql.LevelSerializable), ReadOnly: true},
+		}))
+	})
+
+	It("begins the transaction with default options", func() {
+		mock.ExpectBegin()
+		mock.ExpectCommit()
+
+		Expect(r.WithTx(ctx, noop)).Should(Succeed())
+		Expect(opts).Should(Equal([]driver.TxOptions{{}}))
+	})
+
+	It("inserts bulk teams without external ids in the transaction", func() {
+		mock.ExpectBegin()
+		mock.ExpectQuery(`INSERT INTO team \(name,description\)`).
+			WithArgs("First", "", "Second", "").
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1).AddRow(2))
+		mock.ExpectCommit()
+
+		err := r.WithTx(ctx, func(tx repo.Repo) error {
+			ids, err := tx.BulkCreateTeams(ctx, []models.Team{
+				{Name: "First", ExternalId: "ext-1"},
+				{Name: "Second", ExternalId: "ext-2"},
+			})
+			Expect(ids).Should(Equal([]uint64{1, 2}))
+			return err
+		})
+		Expect(err).Should(BeNil())
+	})
+
+	It("joins the outer transaction in nested calls", func() {
+		mock.ExpectBegin()
+		mock.ExpectExec("UPDATE team SET is_deleted").WithArgs(true, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		err := r.WithTx(ctx, func(tx repo.Repo) error {
+			return tx.WithTx(ctx, func(nested repo.Repo) error {
+				Expect(nested).Should(BeIdenticalTo(tx))
+				return nested.RemoveTeam(ctx, 1)
+			}, repo.ReadOnly())
+		})
+		Expect(err).Should(BeNil())
+		Expect(opts).Should(HaveLen(1))
+		Expect(opts[0].ReadOnly).Should(BeFalse())
+	})
+})
@@ -0,0 +1,200 @@
+package repo
+
+import (
+	"context"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+	"github.com/ozoncp/ocp-team-api/internal/models"
+	"strings"
+)
+
+const (
+	webhookTableName  = "webhook"
+	deliveryTableName = "webhook_delivery"
+)
+
+// WebhookRepo is the interface that wraps storage operations on webhook subscriptions
+// and their delivery log.
+type WebhookRepo interface {
+	CreateWebhook(ctx context.Context, webhook *models.Webhook) error
+	ListWebhooks(ctx context.Context) ([]models.Webhook, error)
+	RemoveWebhook(ctx context.Context, webhookId uint64) error
+	RegisterFailure(ctx context.Context, webhookId uint64, disableAfter uint32) (bool, error)
+	ResetFailures(ctx context.Context, webhookId uint64) error
+	AddDelivery(ctx context.Context, delivery *models.WebhookDelivery) error
+	ListDeliveries(ctx context.Context, webhookId, limit uint64) ([]models.WebhookDelivery, error)
+}
+
+// NewWebhookRepo is the constructor method for webhookRepo struct.
+func NewWebhookRepo(db *sqlx.DB) *webhookRepo {
+	return &webhookRepo{db}
+}
+
+// webhookRepo is the struct that implements WebhookRepo interface through sqlx library.
+type webhookRepo struct {
+	db *sqlx.DB
+}
+
+// CreateWebhook is the method for creating new webhook subscription through SQL INSERT.
+// New subscription is always enabled.
+func (r *webhookRepo) CreateWebhook(ctx context.Context, webhook *models.Webhook) error {
+	query := sq.Insert(webhookTableName).
+		Columns("url", "events", "secret").
+		Values(webhook.URL, strings.Join(webhook.Events, ","), webhook.Secret).
+		Suffix("RETURNING id").
+		RunWith(r.db).
+		PlaceholderFormat(sq.Dollar)
+
+	if err := query.QueryRowContext(ctx).Scan(&webhook.Id); err != nil {
+		return err
+	}
+
+	webhook.IsEnabled = true
+
+	return nil
+}
+
+// ListWebhooks is the method for fetching all webhook subscriptions including disabled ones.
+func (r *webhookRepo) ListWebhooks(ctx context.Context) ([]models.Webhook, error) {
+	query := sq.Select("id", "url", "events", "secret", "is_enabled", "failures").
+		From(webhookTableName).
+		OrderBy("id").
+		RunWith(r.db).
+		PlaceholderFormat(sq.Dollar)
+
+	rows, err := query.QueryContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var webhooks []models.Webhook
+	for rows.Next() {
+		var (
+			webhook models.Webhook
+			events  string
+		)
+		err = rows.Scan(&webhook.Id, &webhook.URL, &events, &webhook.Secret, &webhook.IsEnabled, &webhook.Failures)
+		if err != nil {
+			return nil, err
+		}
+
+		if events != "" {
+			webhook.Events = strings.Split(events, ",")
+		}
+
+		webhooks = append(webhooks, webhook)
+	}
+
+	return webhooks, rows.Err()
+}
+
+// RemoveWebhook is the method that deletes webhook subscription with its delivery log.
+func (r *webhookRepo) RemoveWebhook(ctx context.Context, webhookId uint64) error {
+	query := sq.Delete(webhookTableName).
+		Where(sq.Eq{"id": webhookId}).
+		RunWith(r.db).
+		PlaceholderFormat(sq.Dollar)
+
+	_, err := query.ExecContext(ctx)
+
+	return err
+}
+
+// RegisterFailure is the method that increments the number of consecutive failures
+// of the subscription and disables it when the number reaches disableAfter.
+// Zero disableAfter never disables the subscription.
+// It returns true if the subscription is disabled.
+func (r *webhookRepo) RegisterFailure(ctx context.Context, webhookId uint64, disableAfter uint32) (bool, error) {
+	enabled := sq.Expr("is_enabled")
+	if disableAfter > 0 {
+		enabled = sq.Expr("is_enabled AND failures + 1 < ?", disableAfter)
+	}
+
+	query := sq.Update(webhookTableName).
+		Set("failures", sq.Expr("failures + 1")).
+		Set("is_enabled", enabled).
+		Where(sq.Eq{"id": webhookId}).
+		Suffix("RETURNING is_enabled").
+		RunWith(r.db).
+		PlaceholderFormat(sq.Dollar)
+
+	var isEnabled bool
+	if err := query.QueryRowContext(ctx).Scan(&isEnabled); err != nil {
+		return false, err
+	}
+
+	return !isEnabled, nil
+}
+
+// ResetFailures is the method that resets the number of consecutive failures
+// after successful delivery.
+func (r *webhookRepo) ResetFailures(ctx context.Context, webhookId uint64) error {
+	query := sq.Update(webhookTableName).
+		Set("failures", 0).
+		Where(sq.And{
+			sq.Eq{"id": webhookId},
+			sq.NotEq{"failures": 0},
+		}).
+		RunWith(r.db).
+		PlaceholderFormat(sq.Dollar)
+
+	_, err := query.ExecContext(ctx)
+
+	return err
+}
+
+// AddDelivery is the method for appending record to the delivery log.
+func (r *webhookRepo) AddDelivery(ctx context.Context, delivery *models.WebhookDelivery) error {
+	query := sq.Insert(deliveryTableName).
+		Columns("webhook_id", "event", "team_id", "success", "attempts", "status_code", "error").
+		Values(
+			delivery.WebhookId,
+			delivery.Event,
+			delivery.TeamId,
+			delivery.Success,
+			delivery.Attempts,
+			delivery.StatusCode,
+			delivery.Error,
+		).
+		Suffix("RETURNING id, delivered_at").
+		RunWith(r.db).
+		PlaceholderFormat(sq.Dollar)
+
+	return query.QueryRowContext(ctx).Scan(&delivery.Id, &delivery.DeliveredAt)
+}
+
+// ListDeliveries is the method for fetching the latest deliveries of the subscription,
+// the newest first.
+func (r *webhookRepo) ListDeliveries(ctx context.Context, webhookId, limit uint64) ([]models.WebhookDelivery, error) {
+	query := sq.Select(
+		"id", "webhook_id", "event", "team_id", "success", "attempts", "status_code", "error", "delivered_at",
+	).
+		From(deliveryTableName).
+		Where(sq.Eq{"webhook_id": webhookId}).
+		OrderBy("id DESC").
+		Limit(limit).
+		RunWith(r.db).
+		PlaceholderFormat(sq.Dollar)
+
+	rows, err := query.QueryContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var deliveries []models.WebhookDelivery
+	for rows.Next() {
+		var d models.WebhookDelivery
+		err = rows.Scan(
+			&d.Id, &d.WebhookId, &d.Event, &d.TeamId, &d.Success, &d.Attempts, &d.StatusCode, &d.Error, &d.DeliveredAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		deliveries = append(deliveries, d)
+	}
+
+	return deliveries, rows.Err()
+}
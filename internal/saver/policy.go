@@ -0,0 +1,329 @@
+package saver
+
+import (
+	"github.com/ozoncp/ocp-team-api/internal/models"
+	"time"
+)
+
+// teamOverhead is the estimated size of the team fields besides name and description.
+const teamOverhead = 32
+
+// FlushPolicy is the interface deciding when the saver flushes its buffer.
+// The saver calls it from a single goroutine, so a policy must not be shared
+// between savers. Teams left in the buffer after the flush are passed to Flushed
+// and count as added at that moment.
+type FlushPolicy interface {
+	// Added is called for every team appended to the buffer.
+	Added(team models.Team, now time.Time)
+	// Flushed is called after every flush with the teams left in the buffer
+	// and the duration of the flush.
+	Flushed(remaining []models.Team, latency time.Duration, now time.Time)
+	// Trigger returns the reason to flush the buffer now or empty string.
+	Trigger(now time.Time) string
+	// Wait returns the delay after which Trigger may fire without new teams.
+	// Negative delay means the policy waits for new teams.
+	Wait(now time.Time) time.Duration
+}
+
+// EstimateTeamSize is the method returning approximate size of the team in bytes.
+func EstimateTeamSize(team models.Team) int {
+	return teamOverhead + len(team.Name) + len(team.Description)
+}
+
+// maxItems is the policy flushing when the buffer holds the number of teams.
+type maxItems struct {
+	limit int
+	count int
+}
+
+// MaxItems is the constructor method for the policy flushing when the buffer holds limit teams.
+func MaxItems(limit int) *maxItems {
+	return &maxItems{limit: limit}
+}
+
+func (p *maxItems) Added(_ models.Team, _ time.Time) {
+	p.count++
+}
+
+func (p *maxItems) Flushed(remaining []models.Team, _ time.Duration, _ time.Time) {
+	p.count = len(remaining)
+}
+
+func (p *maxItems) Trigger(_ time.Time) string {
+	if p.count > 0 && p.count >= p.limit {
+		return "capacity"
+	}
+	return ""
+}
+
+func (p *maxItems) Wait(_ time.Time) time.Duration {
+	return -1
+}
+
+// maxBytes is the policy flushing when the estimated size of the buffer reaches the limit.
+type maxBytes struct {
+	limit int
+	size  int
+}
+
+// MaxBytes is the constructor method for the policy flushing when the estimated size
+// of the buffered teams (see EstimateTeamSize) reaches limit bytes.
+func MaxBytes(limit int) *maxBytes {
+	return &maxBytes{limit: limit}
+}
+
+func (p *maxBytes) Added(team models.Team, _ time.Time) {
+	p.size += EstimateTeamSize(team)
+}
+
+func (p *maxBytes) Flushed(remaining []models.Team, _ time.Duration, _ time.Time) {
+	p.size = 0
+	for _, team := range remaining {
+		p.size += EstimateTeamSize(team)
+	}
+}
+
+func (p *maxBytes) Trigger(_ time.Time) string {
+	if p.size > 0 && p.size >= p.limit {
+		return "bytes"
+	}
+	return ""
+}
+
+func (p *maxBytes) Wait(_ time.Time) time.Duration {
+	return -1
+}
+
+// maxAge is the policy flushing when the oldest team in the buffer reaches the age.
+type maxAge struct {
+	age    time.Duration
+	oldest time.Time
+}
+
+// MaxAge is the constructor method for the policy flushing when the oldest
+// team in the buffer waits for age.
+func MaxAge(age time.Duration) *maxAge {
+	return &maxAge{age: age}
+}
+
+func (p *maxAge) Added(_ models.Team, now time.Time) {
+	if p.oldest.IsZero() {
+		p.oldest = now
+	}
+}
+
+func (p *maxAge) Flushed(remaining []models.Team, _ time.Duration, now time.Time) {
+	p.oldest = time.Time{}
+	if len(remaining) > 0 {
+		p.oldest = now
+	}
+}
+
+func (p *maxAge) Trigger(now time.Time) string {
+	if !p.oldest.IsZero() && now.Sub(p.oldest) >= p.age {
+		return "age"
+	}
+	return ""
+}
+
+func (p *maxAge) Wait(now time.Time) time.Duration {
+	if p.oldest.IsZero() {
+		return -1
+	}
+	return positive(p.oldest.Add(p.age).Sub(now))
+}
+
+// interval is the policy flushing non-empty buffer on fixed ticks.
+type interval struct {
+	period time.Duration
+	due    time.Time
+	count  int
+}
+
+// Interval is the constructor method for the policy flushing non-empty buffer
+// every period like a ticker. Ticks passed while the buffer is empty are skipped.
+func Interval(period time.Duration) *interval {
+	return &interval{period: period}
+}
+
+func (p *interval) Added(_ models.Team, now time.Time) {
+	if p.count == 0 {
+		p.advance(now)
+	}
+	p.count++
+}
+
+func (p *interval) Flushed(remaining []models.Team, _ time.Duration, now time.Time) {
+	p.count = len(remaining)
+	p.advance(now)
+}
+
+func (p *interval) Trigger(now time.Time) string {
+	if now.Before(p.due) {
+		return ""
+	}
+
+	p.advance(now)
+	if p.count == 0 {
+		return ""
+	}
+	return "ticker"
+}
+
+func (p *interval) Wait(now time.Time) time.Duration {
+	if p.count == 0 {
+		return -1
+	}
+	return positive(p.due.Sub(now))
+}
+
+// advance is the method that moves the due tick past now.
+func (p *interval) advance(now time.Time) {
+	if p.due.IsZero() {
+		p.due = now.Add(p.period)
+		return
+	}
+
+	if !now.Before(p.due) {
+		ticks := now.Sub(p.due)/p.period + 1
+		p.due = p.due.Add(ticks * p.period)
+	}
+}
+
+// adaptive is the policy flushing by the age of the oldest team with the limit
+// derived from recent flush latency.
+type adaptive struct {
+	min     time.Duration
+	max     time.Duration
+	factor  float64
+	latency time.Duration
+	oldest  time.Time
+}
+
+// adaptiveSmoothing is the weight of the latest flush in the moving average of latency.
+const adaptiveSmoothing = 0.3
+
+// Adaptive is the constructor method for the policy flushing when the oldest team
+// waits for factor times the moving average of flush latency limited by min and max.
+// Slow database makes the saver collect larger batches.
+func Adaptive(min, max time.Duration, factor float64) *adaptive {
+	return &adaptive{min: min, max: max, factor: factor}
+}
+
+func (p *adaptive) Added(_ models.Team, now time.Time) {
+	if p.oldest.IsZero() {
+		p.oldest = now
+	}
+}
+
+func (p *adaptive) Flushed(remaining []models.Team, latency time.Duration, now time.Time) {
+	if p.latency == 0 {
+		p.latency = latency
+	} else {
+		p.latency = time.Duration(adaptiveSmoothing*float64(latency) + (1-adaptiveSmoothing)*float64(p.latency))
+	}
+
+	p.oldest = time.Time{}
+	if len(remaining) > 0 {
+		p.oldest = now
+	}
+}
+
+func (p *adaptive) Trigger(now time.Time) string {
+	if !p.oldest.IsZero() && now.Sub(p.oldest) >= p.Interval() {
+		return "adaptive"
+	}
+	return ""
+}
+
+func (p *adaptive) Wait(now time.Time) time.Duration {
+	if p.oldest.IsZero() {
+		return -1
+	}
+	return positive(p.oldest.Add(p.Interval()).Sub(now))
+}
+
+// Interval is the method returning the current age limit of the oldest team.
+func (p *adaptive) Interval() time.Duration {
+	interval := time.Duration(p.factor * float64(p.latency))
+	if interval < p.min {
+		return p.min
+	}
+	if interval > p.max {
+		return p.max
+	}
+	return interval
+}
+
+// composite is the policy combining several policies.
+type composite struct {
+	policies []FlushPolicy
+	all      bool
+}
+
+// Any is the constructor method for the policy flushing when any of the policies fires.
+func Any(policies ...FlushPolicy) *composite {
+	return &composite{policies: policies}
+}
+
+// All is the constructor method for the policy flushing when all the policies fire at once.
+func All(policies ...FlushPolicy) *composite {
+	return &composite{policies: policies, all: true}
+}
+
+func (p *composite) Added(team models.Team, now time.Time) {
+	for _, policy := range p.policies {
+		policy.Added(team, now)
+	}
+}
+
+func (p *composite) Flushed(remaining []models.Team, latency time.Duration, now time.Time) {
+	for _, policy := range p.policies {
+		policy.Flushed(remaining, latency, now)
+	}
+}
+
+// Trigger is the method that asks every policy, so stateful policies see every check.
+// It returns the reason of the first fired policy.
+func (p *composite) Trigger(now time.Time) string {
+	var trigger string
+	fired := 0
+
+	for _, policy := range p.policies {
+		if t := policy.Trigger(now); t != "" {
+			fired++
+			if trigger == "" {
+				trigger = t
+			}
+		}
+	}
+
+	if p.all && fired < len(p.policies) {
+		return ""
+	}
+	return trigger
+}
+
+// Wait is the method returning the shortest wait of the policies. For All policies
+// already fired are skipped, the rest of them decide when to check again.
+func (p *composite) Wait(now time.Time) time.Duration {
+	wait := time.Duration(-1)
+
+	for _, policy := range p.policies {
+		w := policy.Wait(now)
+		if w < 0 || (p.all && w == 0) {
+			continue
+		}
+		if wait < 0 || w < wait {
+			wait = w
+		}
+	}
+	return wait
+}
+
+func positive(d time.Duration) time.Duration {
+	if d < 0 {
+		return 0
+	}
+	return d
+}
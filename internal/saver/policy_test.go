@@ -0,0 +1,115 @@
+package saver_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/gomega"
+	"github.com/ozoncp/ocp-team-api/internal/models"
+	"github.com/ozoncp/ocp-team-api/internal/saver"
+	"time"
+)
+
+var _ = Describe("FlushPolicy", func() {
+	start := time.Date(2021, 10, 19, 12, 0, 0, 0, time.UTC)
+	team := models.Team{Name: "Name", Description: "Description"}
+
+	Context("MaxItems", func() {
+		It("fires when the buffer holds limit teams", func() {
+			policy := saver.MaxItems(2)
+
+			policy.Added(team, start)
+			gomega.Expect(policy.Trigger(start)).Should(gomega.BeEmpty())
+
+			policy.Added(team, start)
+			gomega.Expect(policy.Trigger(start)).Should(gomega.Equal("capacity"))
+
+			policy.Flushed(nil, time.Millisecond, start)
+			gomega.Expect(policy.Trigger(start)).Should(gomega.BeEmpty())
+			gomega.Expect(policy.Wait(start)).Should(gomega.BeNumerically("<", 0))
+		})
+	})
+
+	Context("MaxBytes", func() {
+		It("fires when estimated size reaches limit", func() {
+			policy := saver.MaxBytes(2 * saver.EstimateTeamSize(team))
+
+			policy.Added(team, start)
+			gomega.Expect(policy.Trigger(start)).Should(gomega.BeEmpty())
+
+			policy.Added(team, start)
+			gomega.Expect(policy.Trigger(start)).Should(gomega.Equal("bytes"))
+
+			policy.Flushed([]models.Team{team}, time.Millisecond, start)
+			gomega.Expect(policy.Trigger(start)).Should(gomega.BeEmpty())
+		})
+	})
+
+	Context("MaxAge", func() {
+		It("fires when the oldest team reaches age", func() {
+			policy := saver.MaxAge(time.Second)
+			gomega.Expect(policy.Wait(start)).Should(gomega.BeNumerically("<", 0))
+
+			policy.Added(team, start)
+			policy.Added(team, start.Add(500*time.Millisecond))
+			gomega.Expect(policy.Wait(start.Add(500 * time.Millisecond))).Should(gomega.Equal(500 * time.Millisecond))
+			gomega.Expect(policy.Trigger(start.Add(999 * time.Millisecond))).Should(gomega.BeEmpty())
+			gomega.Expect(policy.Trigger(start.Add(time.Second))).Should(gomega.Equal("age"))
+
+			policy.Flushed([]models.Team{team}, time.Millisecond, start.Add(time.Second))
+			gomega.Expect(policy.Wait(start.Add(time.Second))).Should(gomega.Equal(time.Second))
+		})
+	})
+
+	Context("Interval", func() {
+		It("fires on ticks when the buffer is not empty", func() {
+			policy := saver.Interval(time.Second)
+
+			policy.Added(team, start)
+			gomega.Expect(policy.Wait(start)).Should(gomega.Equal(time.Second))
+			gomega.Expect(policy.Trigger(start.Add(time.Second))).Should(gomega.Equal("ticker"))
+
+			policy.Flushed(nil, time.Millisecond, start.Add(time.Second))
+			gomega.Expect(policy.Wait(start.Add(time.Second))).Should(gomega.BeNumerically("<", 0))
+
+			// ticks passed while the buffer is empty are skipped
+			policy.Added(team, start.Add(3500*time.Millisecond))
+			gomega.Expect(policy.Wait(start.Add(3500 * time.Millisecond))).Should(gomega.Equal(500 * time.Millisecond))
+		})
+	})
+
+	Context("Adaptive", func() {
+		It("adjusts the age limit to the flush latency", func() {
+			policy := saver.Adaptive(100*time.Millisecond, time.Second, 10)
+			gomega.Expect(policy.Interval()).Should(gomega.Equal(100 * time.Millisecond))
+
+			policy.Flushed(nil, 50*time.Millisecond, start)
+			gomega.Expect(policy.Interval()).Should(gomega.Equal(500 * time.Millisecond))
+
+			policy.Flushed(nil, time.Second, start)
+			gomega.Expect(policy.Interval()).Should(gomega.Equal(time.Second))
+
+			policy.Added(team, start)
+			gomega.Expect(policy.Trigger(start.Add(time.Second))).Should(gomega.Equal("adaptive"))
+		})
+	})
+
+	Context("combinations", func() {
+		It("Any fires when one of the policies fires", func() {
+			policy := saver.Any(saver.MaxItems(2), saver.MaxAge(time.Second))
+
+			policy.Added(team, start)
+			gomega.Expect(policy.Wait(start)).Should(gomega.Equal(time.Second))
+			gomega.Expect(policy.Trigger(start.Add(time.Second))).Should(gomega.Equal("age"))
+		})
+
+		It("All fires when all the policies fire", func() {
+			policy := saver.All(saver.MaxItems(2), saver.MaxAge(time.Second))
+
+			policy.Added(team, start)
+			gomega.Expect(policy.Trigger(start.Add(time.Second))).Should(gomega.BeEmpty())
+			gomega.Expect(policy.Wait(start.Add(time.Second))).Should(gomega.BeNumerically("<", 0))
+
+			policy.Added(team, start.Add(time.Second))
+			gomega.Expect(policy.Trigger(start.Add(time.Second))).Should(gomega.Equal("capacity"))
+		})
+	})
+})
@@ -3,102 +3,643 @@ package saver
 import (
 	"context"
 	"errors"
+	"fmt"
 	"github.com/ozoncp/ocp-team-api/internal/flusher"
+	"github.com/ozoncp/ocp-team-api/internal/metrics"
 	"github.com/ozoncp/ocp-team-api/internal/models"
+	"github.com/ozoncp/ocp-team-api/internal/wal"
+	"github.com/rs/zerolog/log"
+	"sync"
 	"time"
 )
 
-type state uint8
+var (
+	// ErrClosed is returned by Save when the saver is already closed.
+	ErrClosed = errors.New("cannot save to the closed saver")
+	// ErrQueueFull is returned by Save when the queue is full and the overflow policy is Error.
+	ErrQueueFull = errors.New("saver queue is full")
+	// ErrTooManyAttempts is passed to the dead-letter handler with teams failed to flush too many times.
+	ErrTooManyAttempts = errors.New("team is not flushed after max attempts")
+)
+
+// attemptsError is the error passed to the dead-letter handler with teams failed to flush
+// too many times. It is ErrTooManyAttempts and wraps the last error of the team, if known.
+type attemptsError struct {
+	attempts int
+	err      error
+}
+
+// Error is the method for converting attemptsError to string representation.
+func (e *attemptsError) Error() string {
+	if e.err == nil {
+		return fmt.Sprintf("%v (%d)", ErrTooManyAttempts, e.attempts)
+	}
+	return fmt.Sprintf("%v (%d): %v", ErrTooManyAttempts, e.attempts, e.err)
+}
+
+// Is is the method reporting that attemptsError is ErrTooManyAttempts.
+func (e *attemptsError) Is(target error) bool {
+	return target == ErrTooManyAttempts
+}
+
+// Unwrap is the method returning the last error of the team.
+func (e *attemptsError) Unwrap() error {
+	return e.err
+}
+
+// Attempts is the method returning the number of failed flushes of the team.
+func (e *attemptsError) Attempts() int {
+	return e.attempts
+}
+
+// OverflowPolicy is the behaviour of Save when the queue is full.
+type OverflowPolicy uint8
+
+const (
+	// Block waits until there is room in the queue or the context is done.
+	Block OverflowPolicy = iota
+	// Drop discards the team without error.
+	Drop
+	// Error returns ErrQueueFull.
+	Error
+)
 
+// Flush triggers reported to metrics besides the ones returned by FlushPolicy.
 const (
-	initialized state = iota
-	closed
+	triggerManual = "manual"
+	triggerClose  = "close"
 )
 
 // Saver is the interface for saving teams.
-// Actual saving to db is done either on ticker event or
-// on when Close() method is called.
+// Actual saving to db is done when the flush policy fires,
+// when Flush() or Close() method is called.
 type Saver interface {
-	Save(team models.Team) error
-	Close()
+	Save(ctx context.Context, team models.Team) error
+	SaveTracked(ctx context.Context, team models.Team, ref models.OperationRef) error
+	Flush(ctx context.Context) error
+	Close(ctx context.Context) ([]models.Team, error)
+}
+
+// Tracker is the interface for reporting outcomes of teams saved by SaveTracked.
+type Tracker interface {
+	Complete(ref models.OperationRef, id uint64)
+	Fail(ref models.OperationRef, err error)
+}
+
+// Option is the type of optional saver settings passed to NewSaver.
+type Option func(s *saver)
+
+// WithQueueSize is the option setting the size of the queue between
+// Save callers and the flushing loop. The default size equals to capacity.
+func WithQueueSize(size uint) Option {
+	return func(s *saver) {
+		s.queueSize = size
+	}
+}
+
+// WithOverflowPolicy is the option setting the behaviour of Save
+// when the queue is full. The default policy is Block.
+func WithOverflowPolicy(policy OverflowPolicy) Option {
+	return func(s *saver) {
+		s.overflow = policy
+	}
+}
+
+// WithFlushPolicy is the option setting the policy deciding when the buffer is flushed.
+// By default, the buffer is flushed when it holds capacity teams or on interval ticks,
+// that is Any(MaxItems(capacity), Interval(interval)).
+func WithFlushPolicy(policy FlushPolicy) Option {
+	return func(s *saver) {
+		s.policy = policy
+	}
+}
+
+// WithWAL is the option enabling write-ahead log: Save returns only after the team
+// is appended to the log, the log is truncated after every flush and teams left
+// in the log by previous run are replayed on construction. Teams returned by Close
+// as unsaved stay in the log until the next run.
+func WithWAL(w wal.WAL) Option {
+	return func(s *saver) {
+		s.wal = w
+	}
+}
+
+// WithMaxFlushAttempts is the option limiting the number of flushes a team may fail.
+// Teams failed maxAttempts times are removed from the buffer and passed to the handler
+// with the error that is ErrTooManyAttempts, implements Attempts() int and wraps the
+// last error of the team reported by the flusher, e.g. *flusher.SinkError.
+// By default, failed teams are flushed again until they succeed.
+func WithMaxFlushAttempts(maxAttempts int, handler flusher.RejectHandler) Option {
+	return func(s *saver) {
+		s.maxAttempts = maxAttempts
+		s.deadLetter = handler
+	}
+}
+
+// WithTracker is the option setting the tracker created and permanently failed teams
+// saved by SaveTracked are reported to. Teams failed to flush are not reported since
+// they are flushed again. By default, outcomes are not reported.
+func WithTracker(tracker Tracker) Option {
+	return func(s *saver) {
+		s.tracker = tracker
+	}
+}
+
+// flushRequest is the request of the immediate flush sent by Flush to the loop.
+type flushRequest struct {
+	ctx  context.Context
+	done chan struct{}
+}
+
+// entry is the element of the save queue. The loop reports the result of
+// appending to the write-ahead log through ack when the log is enabled.
+type entry struct {
+	item buffered
+	ack  chan error
+}
+
+// buffered is the team in the buffer together with the reference to the operation
+// it is created by, the number of its failed flushes and the last error of the flush.
+// They are kept by the saver only, so the flusher and the write-ahead log never see them.
+type buffered struct {
+	team     models.Team
+	ref      *models.OperationRef
+	attempts int
+	err      error
+}
+
+// flushedTeams is the index of flushed teams by their content. The flusher reports
+// copies of teams with ids assigned, so they are matched to the buffer by the rest
+// of the fields. Teams of the same content are interchangeable, so the first one
+// not matched yet is taken.
+type flushedTeams map[models.Team][]buffered
+
+// newFlushedTeams is the constructor method for flushedTeams of the buffer.
+func newFlushedTeams(items []buffered) flushedTeams {
+	flushed := make(flushedTeams, len(items))
+	for _, item := range items {
+		key := item.team
+		key.Id = 0
+		flushed[key] = append(flushed[key], item)
+	}
+
+	return flushed
+}
+
+// take is the method that removes the buffered team the flushed team is the copy of.
+// The returned item holds the flushed team.
+func (f flushedTeams) take(team models.Team) buffered {
+	key := team
+	key.Id = 0
+
+	item := buffered{}
+	if items := f[key]; len(items) > 0 {
+		item, f[key] = items[0], items[1:]
+	}
+	item.team = team
+
+	return item
+}
+
+// flushErrors is the index of errors of failed teams by their content, see flushedTeams.
+type flushErrors map[models.Team][]error
+
+// newFlushErrors is the constructor method for flushErrors of the items.
+func newFlushErrors(items []flusher.ItemError) flushErrors {
+	errs := make(flushErrors, len(items))
+	for _, item := range items {
+		key := item.Team
+		key.Id = 0
+		errs[key] = append(errs[key], item.Err)
+	}
+
+	return errs
+}
+
+// take is the method that removes the error of the failed team, it returns nil if there is none.
+func (e flushErrors) take(team models.Team) error {
+	key := team
+	key.Id = 0
+
+	errs := e[key]
+	if len(errs) == 0 {
+		return nil
+	}
+	e[key] = errs[1:]
+
+	return errs[0]
 }
 
 // saver is the struct that implements Saver interface.
+// The buffer is owned by the single loop goroutine, callers
+// communicate with it through the bounded queue only.
 type saver struct {
-	flusher  flusher.Flusher
-	teams    []models.Team
-	teamsCh  chan models.Team
-	doneCh   chan int
-	ticker   *time.Ticker
-	state    state
-	capacity uint
+	flusher   flusher.Flusher
+	capacity  uint
+	queueSize uint
+	overflow  OverflowPolicy
+	policy    FlushPolicy
+	wal       wal.WAL
+	tracker   Tracker
+	buffer    []buffered
+	teamsCh   chan entry
+	flushCh   chan flushRequest
+	closeCh   chan struct{}
+	doneCh    chan struct{}
+	closeOnce sync.Once
+	closeCtx  context.Context
+
+	// ctx is the lifetime context of policy flushes, it is cancelled by Close,
+	// so the flush blocked in retries does not delay the final flush.
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	// maxAttempts limits failed flushes of a team, deadLetter receives teams over the limit.
+	maxAttempts int
+	deadLetter  flusher.RejectHandler
+
+	// mu guards closed flag, so no team is enqueued after the loop drained the queue.
+	mu      sync.RWMutex
+	closed  bool
+	unsaved []models.Team
 }
 
 // NewSaver is the constructor method for saver struct.
-// In addition, it constructs ticker.
-func NewSaver(capacity uint, flusher flusher.Flusher, interval time.Duration) *saver {
+// It returns nil if capacity or interval is not positive.
+func NewSaver(capacity uint, flusher flusher.Flusher, interval time.Duration, opts ...Option) *saver {
 	if capacity == 0 || interval <= 0 {
 		return nil
 	}
 
 	s := &saver{
-		flusher:  flusher,
-		teams:    make([]models.Team, 0, capacity),
-		teamsCh:  make(chan models.Team),
-		doneCh:   make(chan int),
-		ticker:   time.NewTicker(interval),
-		state:    initialized,
-		capacity: capacity,
-	}
-
-	go func() {
-		defer s.ticker.Stop()
-
-		for {
-			select {
-			case team := <-s.teamsCh:
-				s.teams = append(s.teams, team)
-				if uint(len(s.teams)) >= s.capacity {
-					s.flush()
+		flusher:   flusher,
+		capacity:  capacity,
+		queueSize: capacity,
+		overflow:  Block,
+		policy:    Any(MaxItems(int(capacity)), Interval(interval)),
+		buffer:    make([]buffered, 0, capacity),
+		flushCh:   make(chan flushRequest),
+		closeCh:   make(chan struct{}),
+		doneCh:    make(chan struct{}),
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	s.teamsCh = make(chan entry, s.queueSize)
+	s.ctx, s.cancel = context.WithCancel(context.Background())
+
+	if s.wal != nil {
+		s.replay()
+	}
+
+	go s.loop()
+
+	return s
+}
+
+func (s *saver) loop() {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	defer close(s.doneCh)
+
+	for {
+		s.resetTimer(timer)
+
+		select {
+		case e := <-s.teamsCh:
+			s.receive(e)
+			s.flushByPolicy()
+		case <-timer.C:
+			s.flushByPolicy()
+		case req := <-s.flushCh:
+			s.drain()
+			s.flush(req.ctx, triggerManual)
+			close(req.done)
+		case <-s.closeCh:
+			// Save cannot enqueue after close, so draining empties the queue for good.
+			s.drain()
+			s.flush(s.closeCtx, triggerClose)
+			s.unsaved = s.teams()
+
+			if s.wal != nil {
+				if err := s.wal.Close(); err != nil {
+					log.Error().Err(err).Msg("cannot close saver wal")
 				}
-			case <-s.ticker.C:
-				s.flush()
-			case <-s.doneCh:
-				s.state = closed
-				s.flusher.Flush(context.TODO(), s.teams)
-				close(s.doneCh)
-				close(s.teamsCh)
-				return
 			}
+			return
 		}
+	}
+}
+
+// resetTimer is the method that arms the timer for the next check of the flush policy.
+func (s *saver) resetTimer(timer *time.Timer) {
+	if !timer.Stop() {
+		select {
+		case <-timer.C:
+		default:
+		}
+	}
+
+	if wait := s.policy.Wait(time.Now()); wait >= 0 {
+		timer.Reset(wait)
+	}
+}
+
+// flushByPolicy is the method that flushes the buffer if the flush policy fires.
+func (s *saver) flushByPolicy() {
+	if trigger := s.policy.Trigger(time.Now()); trigger != "" {
+		s.flush(s.ctx, trigger)
+	}
+}
+
+// drain is the method that moves all entries waiting in the queue to the buffer.
+func (s *saver) drain() {
+	for drained := false; !drained; {
+		select {
+		case e := <-s.teamsCh:
+			s.receive(e)
+		default:
+			drained = true
+		}
+	}
+}
+
+// receive is the method that moves the entry together with the entries already
+// waiting in the queue to the buffer. With the write-ahead log enabled they are
+// appended to the log at once and acknowledged.
+func (s *saver) receive(first entry) {
+	batch := []entry{first}
+	for drained := false; !drained && len(batch) < cap(s.teamsCh); {
+		select {
+		case e := <-s.teamsCh:
+			batch = append(batch, e)
+		default:
+			drained = true
+		}
+	}
+
+	defer func() {
+		metrics.SetSaverQueueDepth(len(s.teamsCh))
+		metrics.SetSaverBufferFillRatio(uint(len(s.buffer)), s.capacity)
 	}()
 
-	return s
+	items := make([]buffered, 0, len(batch))
+	for _, e := range batch {
+		items = append(items, e.item)
+	}
+
+	if s.wal == nil {
+		s.append(items...)
+		return
+	}
+
+	teams := make([]models.Team, 0, len(batch))
+	for _, e := range batch {
+		teams = append(teams, e.item.team)
+	}
+
+	err := s.wal.Append(teams...)
+	if err == nil {
+		s.append(items...)
+	}
+
+	for _, e := range batch {
+		e.ack <- err
+	}
 }
 
-func (s *saver) flush() {
-	failed := s.flusher.Flush(context.TODO(), s.teams)
-	s.teams = make([]models.Team, 0, s.capacity)
-	s.teams = append(s.teams, failed...)
+// append is the method that adds teams to the buffer and reports them to the flush policy.
+func (s *saver) append(items ...buffered) {
+	now := time.Now()
+	for _, item := range items {
+		s.buffer = append(s.buffer, item)
+		s.policy.Added(item.team, now)
+	}
 }
 
-// Save is the method for adding new team to the save channel.
-func (s *saver) Save(team models.Team) error {
-	if s.state == closed {
-		return errors.New("cannot save to the closed saver")
+// teams is the method returning teams of the buffer.
+func (s *saver) teams() []models.Team {
+	teams := make([]models.Team, 0, len(s.buffer))
+	for _, item := range s.buffer {
+		teams = append(teams, item.team)
 	}
 
-	s.teamsCh <- team
+	return teams
+}
+
+// replay is the method that restores teams left in the write-ahead log
+// by the previous run and compacts the log.
+func (s *saver) replay() {
+	teams, err := s.wal.Replay()
+	if err != nil {
+		log.Error().Err(err).Msgf("saver wal replay recovered %d teams", len(teams))
+	}
 
-	return nil
+	for _, team := range teams {
+		s.append(buffered{team: team})
+	}
+
+	if err = s.wal.Checkpoint(s.teams()); err != nil {
+		log.Error().Err(err).Msg("cannot checkpoint saver wal")
+	}
 }
 
-// Close is the method for closing the saver.
-// It sends value to the done channel to close it.
-func (s *saver) Close() {
-	if s.state == closed {
+// flush is the method that passes the buffer to the flusher, reports the outcome
+// to the tracker and keeps the failed teams for the next flush.
+// Trigger is the reason reported to metrics.
+func (s *saver) flush(ctx context.Context, trigger string) {
+	if len(s.buffer) == 0 {
 		return
 	}
 
-	s.doneCh <- 1
+	start := time.Now()
+	result := s.flusher.Flush(ctx, s.teams())
+	latency := time.Since(start)
+	metrics.ObserveSaverFlush(trigger, latency)
+
+	flushed := newFlushedTeams(s.buffer)
+	s.buffer = make([]buffered, 0, s.capacity)
+	s.track(flushed, result)
+	s.retain(ctx, flushed, result)
+	s.policy.Flushed(s.teams(), latency, time.Now())
+	metrics.SetSaverBufferFillRatio(uint(len(s.buffer)), s.capacity)
+
+	if s.wal != nil {
+		if err := s.wal.Checkpoint(s.teams()); err != nil {
+			log.Error().Err(err).Msg("cannot checkpoint saver wal")
+		}
+	}
+}
+
+// track is the method that reports created and rejected teams to the tracker.
+func (s *saver) track(flushed flushedTeams, result flusher.Result) {
+	for _, team := range result.Created {
+		if item := flushed.take(team); item.ref != nil && s.tracker != nil {
+			s.tracker.Complete(*item.ref, team.Id)
+		}
+	}
+
+	for _, rejected := range result.Rejected {
+		if item := flushed.take(rejected.Team); item.ref != nil && s.tracker != nil {
+			s.tracker.Fail(*item.ref, rejected.Err)
+		}
+	}
+}
+
+// retain is the method that keeps failed teams in the buffer for the next flush.
+// Teams created but not written to the sinks keep their id, so they are not created again.
+// With max flush attempts set, teams that reach the limit are passed to the dead-letter
+// handler and reported to the tracker.
+func (s *saver) retain(ctx context.Context, flushed flushedTeams, result flusher.Result) {
+	errs := newFlushErrors(result.Unwritten)
+
+	for _, team := range result.Failed {
+		item := flushed.take(team)
+		item.team.Id = team.Id
+		item.err = errs.take(team)
+		// The flush interrupted by Close is not the failed attempt, the final flush retries the team.
+		if ctx.Err() == nil {
+			item.attempts++
+		}
+
+		if s.maxAttempts <= 0 || s.deadLetter == nil || item.attempts < s.maxAttempts {
+			s.buffer = append(s.buffer, item)
+			continue
+		}
+
+		err := &attemptsError{attempts: item.attempts, err: item.err}
+		s.deadLetter.Reject(ctx, item.team, err)
+		if item.ref != nil && s.tracker != nil {
+			s.tracker.Fail(*item.ref, err)
+		}
+	}
+}
+
+// Save is the method for adding new team to the save queue.
+// When the queue is full the behaviour depends on the overflow policy.
+// With the write-ahead log enabled it returns after the team is appended to the log;
+// ctx error returned while waiting for it does not mean the team is discarded.
+// It returns ErrClosed if the saver is closed.
+func (s *saver) Save(ctx context.Context, team models.Team) error {
+	return s.save(ctx, buffered{team: team})
+}
+
+// SaveTracked is the method for adding new team created by the asynchronous operation
+// to the save queue. It behaves like Save, the outcome of the team is reported to the tracker.
+// The reference is not written to the write-ahead log, so teams replayed after restart
+// are not tracked.
+func (s *saver) SaveTracked(ctx context.Context, team models.Team, ref models.OperationRef) error {
+	return s.save(ctx, buffered{team: team, ref: &ref})
+}
+
+// save is the method that enqueues the buffered team and waits until it is appended to the log.
+func (s *saver) save(ctx context.Context, item buffered) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	e := entry{item: item}
+	if s.wal != nil {
+		e.ack = make(chan error, 1)
+	}
+
+	enqueued, err := s.enqueue(ctx, e)
+	if err != nil || !enqueued || e.ack == nil {
+		return err
+	}
+
+	select {
+	case err = <-e.ack:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// enqueue is the method that puts the entry to the queue according to the overflow policy.
+// It returns false without error if the entry is dropped.
+func (s *saver) enqueue(ctx context.Context, e entry) (bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.closed {
+		return false, ErrClosed
+	}
+	defer func() {
+		metrics.SetSaverQueueDepth(len(s.teamsCh))
+	}()
+
+	switch s.overflow {
+	case Drop:
+		select {
+		case s.teamsCh <- e:
+			return true, nil
+		default:
+			log.Warn().Msgf("saver queue is full, team %s is dropped", e.item.team)
+			return false, nil
+		}
+	case Error:
+		select {
+		case s.teamsCh <- e:
+			return true, nil
+		default:
+			return false, ErrQueueFull
+		}
+	default:
+		select {
+		case s.teamsCh <- e:
+			return true, nil
+		case <-ctx.Done():
+			return false, ctx.Err()
+		}
+	}
+}
+
+// Flush is the method for flushing the buffer together with the teams
+// waiting in the queue immediately. It waits for the flush to finish,
+// teams failed to flush stay in the buffer.
+// It returns ErrClosed if the saver is closed.
+func (s *saver) Flush(ctx context.Context) error {
+	req := flushRequest{ctx: ctx, done: make(chan struct{})}
+
+	select {
+	case s.flushCh <- req:
+	case <-s.closeCh:
+		return ErrClosed
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	select {
+	case <-req.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Close is the method for closing the saver.
+// It waits for the final flush and returns teams that could not be persisted.
+// The flush started by the flush policy is cancelled, its teams are flushed again by the final flush.
+// It returns ctx error if ctx is done before the final flush finished,
+// the flush itself keeps running with the ctx of the first Close call.
+func (s *saver) Close(ctx context.Context) ([]models.Team, error) {
+	s.closeOnce.Do(func() {
+		s.mu.Lock()
+		s.closed = true
+		s.mu.Unlock()
+
+		s.closeCtx = ctx
+		s.cancel()
+		close(s.closeCh)
+	})
+
+	select {
+	case <-s.doneCh:
+		return s.unsaved, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
 }
@@ -1,12 +1,20 @@
 package saver_test
 
 import (
+	"context"
+	"errors"
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo"
 	"github.com/onsi/gomega"
+	"github.com/ozoncp/ocp-team-api/internal/flusher"
 	"github.com/ozoncp/ocp-team-api/internal/mocks"
 	"github.com/ozoncp/ocp-team-api/internal/models"
+	"github.com/ozoncp/ocp-team-api/internal/operation"
 	"github.com/ozoncp/ocp-team-api/internal/saver"
+	"github.com/ozoncp/ocp-team-api/internal/wal"
+	"io/ioutil"
+	"os"
+	"sync"
 	"time"
 )
 
@@ -15,17 +23,32 @@ var _ = Describe("Saver", func() {
 		ctrl        *gomock.Controller
 		mockFlusher *mocks.MockFlusher
 		s           saver.Saver
+		ctx         context.Context
 	)
 
 	BeforeEach(func() {
 		ctrl = gomock.NewController(GinkgoT())
 		mockFlusher = mocks.NewMockFlusher(ctrl)
+		ctx = context.Background()
 	})
 
 	AfterEach(func() {
 		ctrl.Finish()
 	})
 
+	// blockFlusher makes the first flush wait until the returned channel is closed,
+	// so the queue can be filled up deterministically.
+	blockFlusher := func() chan struct{} {
+		release := make(chan struct{})
+		mockFlusher.EXPECT().Flush(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ []models.Team) flusher.Result {
+				<-release
+				return flusher.Result{}
+			}).AnyTimes()
+
+		return release
+	}
+
 	Context("when saver has no capacity", func() {
 		It("returns nil on saver creation", func() {
 			mockFlusher.EXPECT().Flush(gomock.Any(), gomock.Any()).Times(0)
@@ -35,7 +58,7 @@ var _ = Describe("Saver", func() {
 			gomega.Expect(s).Should(gomega.BeNil())
 
 			gomega.Expect(func() {
-				s.Close()
+				_, _ = s.Close(ctx)
 			}).Should(gomega.Panic())
 		})
 	})
@@ -49,46 +72,418 @@ var _ = Describe("Saver", func() {
 			gomega.Expect(s).Should(gomega.BeNil())
 
 			gomega.Expect(func() {
-				s.Close()
+				_, _ = s.Close(ctx)
 			}).Should(gomega.Panic())
 		})
 	})
 
 	Context("when saver's capacity overloaded", func() {
 		It("flushes elements", func() {
-			mockFlusher.EXPECT().Flush(gomock.Any(), gomock.Any()).Return([]models.Team{}).AnyTimes()
+			mockFlusher.EXPECT().Flush(gomock.Any(), gomock.Any()).Return(flusher.Result{}).MinTimes(5)
 			s = saver.NewSaver(1, mockFlusher, 10*time.Second)
 
 			for i := 0; i < 5; i++ {
-				_ = s.Save(models.Team{Id: uint64(i), Name: "Name", Description: "Desc"})
+				gomega.Expect(s.Save(ctx, models.Team{Id: uint64(i), Name: "Name", Description: "Desc"})).Should(gomega.Succeed())
 			}
 
-			s.Close()
+			unsaved, err := s.Close(ctx)
+			gomega.Expect(err).Should(gomega.BeNil())
+			gomega.Expect(unsaved).Should(gomega.BeEmpty())
+		})
+	})
+
+	Context("when ticker fires", func() {
+		It("flushes buffered elements", func() {
+			flushed := make(chan []models.Team, 1)
+			mockFlusher.EXPECT().Flush(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, teams []models.Team) flusher.Result {
+					flushed <- teams
+					return flusher.Result{Created: teams}
+				})
+			s = saver.NewSaver(10, mockFlusher, 10*time.Millisecond)
+
+			team := models.Team{Id: 1, Name: "Name", Description: "Desc"}
+			gomega.Expect(s.Save(ctx, team)).Should(gomega.Succeed())
+			gomega.Eventually(flushed).Should(gomega.Receive(gomega.Equal([]models.Team{team})))
+
+			_, err := s.Close(ctx)
+			gomega.Expect(err).Should(gomega.BeNil())
+		})
+	})
+
+	Context("when Flush() is called", func() {
+		It("flushes queued teams immediately", func() {
+			team := models.Team{Id: 1, Name: "Name", Description: "Desc"}
+			mockFlusher.EXPECT().Flush(gomock.Any(), []models.Team{team}).Return(flusher.Result{Created: []models.Team{team}}).Times(1)
+			s = saver.NewSaver(10, mockFlusher, 10*time.Second)
+
+			gomega.Expect(s.Save(ctx, team)).Should(gomega.Succeed())
+			gomega.Expect(s.Flush(ctx)).Should(gomega.Succeed())
+
+			unsaved, err := s.Close(ctx)
+			gomega.Expect(err).Should(gomega.BeNil())
+			gomega.Expect(unsaved).Should(gomega.BeEmpty())
+		})
+
+		It("returns error on closed saver", func() {
+			s = saver.NewSaver(10, mockFlusher, 10*time.Second)
+
+			_, _ = s.Close(ctx)
+			gomega.Expect(s.Flush(ctx)).Should(gomega.Equal(saver.ErrClosed))
+		})
+	})
+
+	Context("when flush policy is set", func() {
+		It("flushes by the policy instead of capacity and interval", func() {
+			flushed := make(chan []models.Team, 1)
+			mockFlusher.EXPECT().Flush(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, teams []models.Team) flusher.Result {
+					flushed <- teams
+					return flusher.Result{Created: teams}
+				})
+			s = saver.NewSaver(1, mockFlusher, 10*time.Second,
+				saver.WithFlushPolicy(saver.MaxAge(10*time.Millisecond)))
+
+			teams := []models.Team{{Id: 1, Name: "Name1"}, {Id: 2, Name: "Name2"}}
+			for _, team := range teams {
+				gomega.Expect(s.Save(ctx, team)).Should(gomega.Succeed())
+			}
+			gomega.Eventually(flushed).Should(gomega.Receive(gomega.Equal(teams)))
+
+			_, err := s.Close(ctx)
+			gomega.Expect(err).Should(gomega.BeNil())
 		})
 	})
 
 	Context("when try to close saver multiple times", func() {
 		It("does not panic", func() {
-			mockFlusher.EXPECT().Flush(gomock.Any(), gomock.Any()).Return(nil).Times(1)
-
 			s = saver.NewSaver(10, mockFlusher, 10*time.Second)
 
-			s.Close()
+			_, _ = s.Close(ctx)
 			gomega.Expect(func() {
-				s.Close()
+				_, _ = s.Close(ctx)
 			}).ShouldNot(gomega.Panic())
 		})
 	})
 
 	Context("when try to Save() on invalid closed state", func() {
 		It("returns error", func() {
-			mockFlusher.EXPECT().Flush(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			s = saver.NewSaver(10, mockFlusher, 10*time.Second)
+
+			_, _ = s.Close(ctx)
+			err := s.Save(ctx, models.Team{Id: 0, Name: "Name", Description: "Desc"})
+			gomega.Expect(err).Should(gomega.Equal(saver.ErrClosed))
+		})
+	})
+
+	Context("when final flush fails", func() {
+		It("returns unsaved teams on close", func() {
+			teams := []models.Team{
+				{Id: 1, Name: "Name1", Description: "Desc1"},
+				{Id: 2, Name: "Name2", Description: "Desc2"},
+			}
+			mockFlusher.EXPECT().Flush(gomock.Any(), teams).Return(flusher.Result{Failed: teams}).Times(1)
+
+			s = saver.NewSaver(10, mockFlusher, 10*time.Second)
+			for _, team := range teams {
+				gomega.Expect(s.Save(ctx, team)).Should(gomega.Succeed())
+			}
+
+			unsaved, err := s.Close(ctx)
+			gomega.Expect(err).Should(gomega.BeNil())
+			gomega.Expect(unsaved).Should(gomega.Equal(teams))
+		})
+	})
+
+	Context("when max flush attempts are set", func() {
+		It("passes teams failed too many times to the dead-letter handler", func() {
+			team := models.Team{Name: "Name", Description: "Desc"}
+			mockFlusher.EXPECT().Flush(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, teams []models.Team) flusher.Result {
+					return flusher.Result{Failed: teams}
+				}).Times(2)
+
+			rejected := make(chan models.Team, 1)
+			var attempts int
+			s = saver.NewSaver(10, mockFlusher, 10*time.Second,
+				saver.WithMaxFlushAttempts(2, flusher.RejectHandlerFunc(
+					func(_ context.Context, team models.Team, err error) {
+						gomega.Expect(err).Should(gomega.MatchError(saver.ErrTooManyAttempts))
+						attempts = err.(interface{ Attempts() int }).Attempts()
+						rejected <- team
+					})))
+
+			gomega.Expect(s.Save(ctx, team)).Should(gomega.Succeed())
+			gomega.Expect(s.Flush(ctx)).Should(gomega.Succeed())
+			gomega.Expect(rejected).ShouldNot(gomega.Receive())
+			gomega.Expect(s.Flush(ctx)).Should(gomega.Succeed())
+
+			var dead models.Team
+			gomega.Expect(rejected).Should(gomega.Receive(&dead))
+			gomega.Expect(dead.Name).Should(gomega.Equal(team.Name))
+			gomega.Expect(attempts).Should(gomega.Equal(2))
+
+			unsaved, err := s.Close(ctx)
+			gomega.Expect(err).Should(gomega.BeNil())
+			gomega.Expect(unsaved).Should(gomega.BeEmpty())
+		})
+	})
+
+	Context("when created teams are not written to the sinks", func() {
+		It("keeps their ids and passes sink error to the dead-letter handler", func() {
+			team := models.Team{Name: "Name", Description: "Desc"}
+			created := team
+			created.Id = 7
+			sinkErr := &flusher.SinkError{Sinks: []string{"kafka"}, Err: errors.New("unavailable")}
+
+			gomock.InOrder(
+				mockFlusher.EXPECT().Flush(gomock.Any(), []models.Team{team}).Return(flusher.Result{
+					Failed:    []models.Team{created},
+					Unwritten: []flusher.ItemError{{Team: created, Err: sinkErr}},
+				}),
+				mockFlusher.EXPECT().Flush(gomock.Any(), []models.Team{created}).Return(flusher.Result{
+					Failed:    []models.Team{created},
+					Unwritten: []flusher.ItemError{{Team: created, Err: sinkErr}},
+				}),
+			)
+
+			rejected := make(chan error, 1)
+			s = saver.NewSaver(10, mockFlusher, 10*time.Second,
+				saver.WithMaxFlushAttempts(2, flusher.RejectHandlerFunc(
+					func(_ context.Context, team models.Team, err error) {
+						gomega.Expect(team).Should(gomega.Equal(created))
+						rejected <- err
+					})))
+
+			gomega.Expect(s.Save(ctx, team)).Should(gomega.Succeed())
+			gomega.Expect(s.Flush(ctx)).Should(gomega.Succeed())
+			gomega.Expect(s.Flush(ctx)).Should(gomega.Succeed())
+
+			var err error
+			gomega.Expect(rejected).Should(gomega.Receive(&err))
+			gomega.Expect(err).Should(gomega.MatchError(saver.ErrTooManyAttempts))
+
+			var unwritten *flusher.SinkError
+			gomega.Expect(errors.As(err, &unwritten)).Should(gomega.BeTrue())
+			gomega.Expect(unwritten.Sinks).Should(gomega.Equal([]string{"kafka"}))
+		})
+	})
+
+	Context("when tracker is set", func() {
+		It("reports created and rejected teams of operations", func() {
+			registry := operation.NewRegistry(0, 0)
+			op := registry.Start(3)
+			teams := []models.Team{{Name: "Team1"}, {Name: "Team2"}, {Name: "Team3"}, {Name: "Team4"}}
+
+			mockFlusher.EXPECT().Flush(gomock.Any(), teams).Return(flusher.Result{
+				Created:  []models.Team{{Id: 1, Name: "Team1"}, {Id: 4, Name: "Team4"}},
+				Failed:   []models.Team{teams[2]},
+				Rejected: []flusher.ItemError{{Team: teams[1], Err: errors.New("invalid")}},
+			})
+			mockFlusher.EXPECT().Flush(gomock.Any(), teams[2:3]).Return(flusher.Result{
+				Created: []models.Team{{Id: 3, Name: "Team3"}},
+			})
 
+			s = saver.NewSaver(10, mockFlusher, 10*time.Second, saver.WithTracker(registry))
+			for i, team := range teams[:3] {
+				gomega.Expect(s.SaveTracked(ctx, team, models.OperationRef{Id: op.Id, Index: i})).Should(gomega.Succeed())
+			}
+			gomega.Expect(s.Save(ctx, teams[3])).Should(gomega.Succeed())
+			gomega.Expect(s.Flush(ctx)).Should(gomega.Succeed())
+
+			op, _ = registry.Get(op.Id)
+			gomega.Expect(op.State).Should(gomega.Equal(operation.Pending))
+			gomega.Expect(op.Created).Should(gomega.Equal(1))
+			gomega.Expect(op.Failed).Should(gomega.Equal(1))
+			gomega.Expect(op.Ids).Should(gomega.Equal([]uint64{1, 0, 0}))
+
+			unsaved, err := s.Close(ctx)
+			gomega.Expect(err).Should(gomega.BeNil())
+			gomega.Expect(unsaved).Should(gomega.BeEmpty())
+
+			op, _ = registry.Get(op.Id)
+			gomega.Expect(op.State).Should(gomega.Equal(operation.Failed))
+			gomega.Expect(op.Ids).Should(gomega.Equal([]uint64{1, 0, 3}))
+		})
+	})
+
+	Context("when queue is full", func() {
+		team := models.Team{Id: 1, Name: "Name", Description: "Desc"}
+
+		// fill saves one team to be stuck in the flusher and fills the queue of size 1.
+		fill := func() {
+			gomega.Expect(s.Save(ctx, team)).Should(gomega.Succeed())
+			gomega.Eventually(func() error {
+				return s.Save(ctx, team)
+			}).Should(gomega.Succeed())
+		}
+
+		It("blocks until context is done with Block policy", func() {
+			release := blockFlusher()
+			s = saver.NewSaver(1, mockFlusher, 10*time.Second, saver.WithQueueSize(1))
+			fill()
+
+			timeoutCtx, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+			defer cancel()
+			gomega.Expect(s.Save(timeoutCtx, team)).Should(gomega.Equal(context.DeadlineExceeded))
+
+			close(release)
+			_, err := s.Close(ctx)
+			gomega.Expect(err).Should(gomega.BeNil())
+		})
+
+		It("returns error with Error policy", func() {
+			release := blockFlusher()
+			s = saver.NewSaver(1, mockFlusher, 10*time.Second, saver.WithQueueSize(1), saver.WithOverflowPolicy(saver.Error))
+			fill()
+
+			gomega.Expect(s.Save(ctx, team)).Should(gomega.Equal(saver.ErrQueueFull))
+
+			close(release)
+			_, err := s.Close(ctx)
+			gomega.Expect(err).Should(gomega.BeNil())
+		})
+
+		It("drops team with Drop policy", func() {
+			release := blockFlusher()
+			s = saver.NewSaver(1, mockFlusher, 10*time.Second, saver.WithQueueSize(1), saver.WithOverflowPolicy(saver.Drop))
+			fill()
+
+			gomega.Expect(s.Save(ctx, team)).Should(gomega.Succeed())
+
+			close(release)
+			_, err := s.Close(ctx)
+			gomega.Expect(err).Should(gomega.BeNil())
+		})
+	})
+
+	Context("when close context is done before final flush", func() {
+		It("returns context error", func() {
+			release := blockFlusher()
 			s = saver.NewSaver(10, mockFlusher, 10*time.Second)
+			gomega.Expect(s.Save(ctx, models.Team{Id: 1, Name: "Name", Description: "Desc"})).Should(gomega.Succeed())
+
+			timeoutCtx, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+			defer cancel()
+			_, err := s.Close(timeoutCtx)
+			gomega.Expect(err).Should(gomega.Equal(context.DeadlineExceeded))
+
+			close(release)
+		})
+	})
+
+	Context("when ticker flush is in progress on close", func() {
+		It("cancels it and flushes the teams with the close context", func() {
+			team := models.Team{Name: "Name", Description: "Desc"}
+			started := make(chan struct{})
+			gomock.InOrder(
+				mockFlusher.EXPECT().Flush(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(flushCtx context.Context, teams []models.Team) flusher.Result {
+						close(started)
+						<-flushCtx.Done()
+						return flusher.Result{Failed: teams}
+					}),
+				mockFlusher.EXPECT().Flush(ctx, []models.Team{team}).DoAndReturn(
+					func(_ context.Context, teams []models.Team) flusher.Result {
+						return flusher.Result{Created: teams}
+					}),
+			)
+			s = saver.NewSaver(10, mockFlusher, 10*time.Millisecond)
+
+			gomega.Expect(s.Save(ctx, team)).Should(gomega.Succeed())
+			gomega.Eventually(started).Should(gomega.BeClosed())
+
+			unsaved, err := s.Close(ctx)
+			gomega.Expect(err).Should(gomega.BeNil())
+			gomega.Expect(unsaved).Should(gomega.BeEmpty())
+		})
+	})
+
+	Context("when used concurrently", func() {
+		It("saves every team exactly once", func() {
+			var (
+				mu    sync.Mutex
+				saved int
+			)
+			mockFlusher.EXPECT().Flush(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, teams []models.Team) flusher.Result {
+					mu.Lock()
+					saved += len(teams)
+					mu.Unlock()
+					return flusher.Result{Created: teams}
+				}).AnyTimes()
+
+			s = saver.NewSaver(3, mockFlusher, time.Millisecond)
+
+			var wg sync.WaitGroup
+			for i := 0; i < 10; i++ {
+				wg.Add(1)
+				go func(i int) {
+					defer wg.Done()
+					for j := 0; j < 10; j++ {
+						_ = s.Save(ctx, models.Team{Id: uint64(i*10 + j), Name: "Name", Description: "Desc"})
+					}
+				}(i)
+			}
+			wg.Wait()
+
+			unsaved, err := s.Close(ctx)
+			gomega.Expect(err).Should(gomega.BeNil())
+			gomega.Expect(unsaved).Should(gomega.BeEmpty())
+
+			mu.Lock()
+			defer mu.Unlock()
+			gomega.Expect(saved).Should(gomega.Equal(100))
+		})
+	})
+
+	Context("when write-ahead log is enabled", func() {
+		var dir string
+
+		teams := []models.Team{
+			{Id: 1, Name: "Name1", Description: "Desc1"},
+			{Id: 2, Name: "Name2", Description: "Desc2"},
+		}
+
+		BeforeEach(func() {
+			var err error
+			dir, err = ioutil.TempDir("", "saver")
+			gomega.Expect(err).Should(gomega.BeNil())
+		})
+
+		AfterEach(func() {
+			_ = os.RemoveAll(dir)
+		})
+
+		It("replays teams left unsaved by the previous run", func() {
+			w, err := wal.Open(dir, wal.Options{})
+			gomega.Expect(err).Should(gomega.BeNil())
+
+			mockFlusher.EXPECT().Flush(gomock.Any(), teams).Return(flusher.Result{Failed: teams[1:]}).Times(1)
+
+			s = saver.NewSaver(10, mockFlusher, 10*time.Second, saver.WithWAL(w))
+			for _, team := range teams {
+				gomega.Expect(s.Save(ctx, team)).Should(gomega.Succeed())
+			}
+
+			unsaved, err := s.Close(ctx)
+			gomega.Expect(err).Should(gomega.BeNil())
+			gomega.Expect(unsaved).Should(gomega.Equal(teams[1:]))
+
+			w, err = wal.Open(dir, wal.Options{})
+			gomega.Expect(err).Should(gomega.BeNil())
+
+			mockFlusher.EXPECT().Flush(gomock.Any(), teams[1:]).Return(flusher.Result{}).Times(1)
+
+			s = saver.NewSaver(10, mockFlusher, 10*time.Second, saver.WithWAL(w))
+			unsaved, err = s.Close(ctx)
+			gomega.Expect(err).Should(gomega.BeNil())
+			gomega.Expect(unsaved).Should(gomega.BeEmpty())
 
-			s.Close()
-			err := s.Save(models.Team{Id: 0, Name: "Name", Description: "Desc"})
-			gomega.Expect(err).ShouldNot(gomega.BeNil())
+			w, err = wal.Open(dir, wal.Options{})
+			gomega.Expect(err).Should(gomega.BeNil())
+			defer w.Close()
+			gomega.Expect(w.Replay()).Should(gomega.BeEmpty())
 		})
 	})
 })
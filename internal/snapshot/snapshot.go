@@ -0,0 +1,186 @@
+package snapshot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/ozoncp/ocp-team-api/internal/kafka"
+	"github.com/ozoncp/ocp-team-api/internal/repo"
+	"github.com/rs/zerolog/log"
+	"golang.org/x/time/rate"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Options is the struct representing settings of the snapshot republishing.
+// FromId and ToId bound the range of team ids (both inclusive, zero means unbounded).
+// Rate is the maximum number of messages per second (zero means unlimited).
+// ProgressFile is the path of the file where the last published id is stored,
+// so the interrupted run can be resumed. Empty path disables progress tracking.
+type Options struct {
+	PageSize     uint64
+	FromId       uint64
+	ToId         uint64
+	Rate         float64
+	ProgressFile string
+}
+
+// Publisher is the interface for republishing current state of teams to the broker.
+type Publisher interface {
+	Publish(ctx context.Context) (uint64, error)
+}
+
+// publisher is the struct that implements Publisher interface.
+type publisher struct {
+	repo     repo.Repo
+	producer kafka.Producer
+	limiter  *rate.Limiter
+	options  Options
+}
+
+// NewPublisher is the constructor method for publisher struct.
+// It returns error if options are inconsistent.
+func NewPublisher(repo repo.Repo, producer kafka.Producer, options Options) (*publisher, error) {
+	if options.PageSize == 0 {
+		return nil, errors.New("page size must be positive")
+	}
+
+	if options.ToId != 0 && options.ToId < options.FromId {
+		return nil, fmt.Errorf("invalid id range [%d, %d]", options.FromId, options.ToId)
+	}
+
+	if options.Rate < 0 {
+		return nil, errors.New("rate must not be negative")
+	}
+
+	limiter := rate.NewLimiter(rate.Inf, 0)
+	if options.Rate > 0 {
+		limiter = rate.NewLimiter(rate.Limit(options.Rate), 1)
+	}
+
+	return &publisher{
+		repo:     repo,
+		producer: producer,
+		limiter:  limiter,
+		options:  options,
+	}, nil
+}
+
+// Publish is the method that streams teams from the repo page by page and
+// sends one Snapshot message per team. It returns the number of published
+// messages. On error the progress file points to the last published team,
+// so the next run continues right after it. The finished run removes the file,
+// so the next run publishes the whole range again.
+func (p *publisher) Publish(ctx context.Context) (uint64, error) {
+	afterId, err := p.startId()
+	if err != nil {
+		return 0, err
+	}
+
+	var published uint64
+
+	for {
+		teams, err := p.repo.ListTeamsAfter(ctx, afterId, p.options.PageSize)
+		if err != nil {
+			return published, err
+		}
+
+		for _, team := range teams {
+			if p.options.ToId != 0 && team.Id > p.options.ToId {
+				return published, p.clearProgress()
+			}
+
+			if err = p.limiter.Wait(ctx); err != nil {
+				return published, p.withProgress(afterId, err)
+			}
+
+			if err = p.producer.Send(kafka.NewSnapshotMessage(team)); err != nil {
+				return published, p.withProgress(afterId, err)
+			}
+
+			afterId = team.Id
+			published++
+		}
+
+		log.Debug().Msgf("snapshot: %d teams published, last id=%d", published, afterId)
+
+		if uint64(len(teams)) < p.options.PageSize {
+			return published, p.clearProgress()
+		}
+
+		if err = p.saveProgress(afterId); err != nil {
+			return published, err
+		}
+	}
+}
+
+// startId is the method for calculating the id after which publishing starts.
+// The stored progress wins over FromId when it is further.
+func (p *publisher) startId() (uint64, error) {
+	var afterId uint64
+	if p.options.FromId > 0 {
+		afterId = p.options.FromId - 1
+	}
+
+	if p.options.ProgressFile == "" {
+		return afterId, nil
+	}
+
+	b, err := ioutil.ReadFile(p.options.ProgressFile)
+	if errors.Is(err, os.ErrNotExist) {
+		return afterId, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	progress, err := strconv.ParseUint(strings.TrimSpace(string(b)), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("corrupted progress file %s: %w", p.options.ProgressFile, err)
+	}
+
+	if progress > afterId {
+		log.Info().Msgf("snapshot: resuming after id=%d", progress)
+		afterId = progress
+	}
+
+	return afterId, nil
+}
+
+// saveProgress is the method for storing the last published id.
+// The file is replaced atomically, so it is never left half-written.
+func (p *publisher) saveProgress(lastId uint64) error {
+	if p.options.ProgressFile == "" {
+		return nil
+	}
+
+	tmp := p.options.ProgressFile + ".tmp"
+	if err := ioutil.WriteFile(tmp, []byte(strconv.FormatUint(lastId, 10)), 0644); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, p.options.ProgressFile)
+}
+
+// clearProgress is the method for removing the progress file of the finished run.
+func (p *publisher) clearProgress() error {
+	if p.options.ProgressFile == "" {
+		return nil
+	}
+
+	if err := os.Remove(p.options.ProgressFile); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
+
+func (p *publisher) withProgress(lastId uint64, err error) error {
+	if progressErr := p.saveProgress(lastId); progressErr != nil {
+		log.Error().Err(progressErr).Msg("snapshot: cannot save progress")
+	}
+
+	return err
+}
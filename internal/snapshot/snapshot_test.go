@@ -0,0 +1,129 @@
+package snapshot_test
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/gomega"
+	"github.com/ozoncp/ocp-team-api/internal/kafka"
+	"github.com/ozoncp/ocp-team-api/internal/mocks"
+	"github.com/ozoncp/ocp-team-api/internal/models"
+	"github.com/ozoncp/ocp-team-api/internal/snapshot"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+var _ = Describe("Snapshot", func() {
+	var (
+		ctrl         *gomock.Controller
+		mockRepo     *mocks.MockRepo
+		mockProducer *mocks.MockProducer
+		progressFile string
+	)
+
+	teams := []models.Team{
+		{Id: 1, Name: "Team1", Description: "Desc1"},
+		{Id: 2, Name: "Team2", Description: "Desc2"},
+		{Id: 5, Name: "Team5", Description: "Desc5"},
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockRepo = mocks.NewMockRepo(ctrl)
+		mockProducer = mocks.NewMockProducer(ctrl)
+
+		dir, err := ioutil.TempDir("", "snapshot")
+		gomega.Expect(err).Should(gomega.BeNil())
+		progressFile = filepath.Join(dir, "progress")
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+		_ = os.RemoveAll(filepath.Dir(progressFile))
+	})
+
+	Context("invalid options", func() {
+		It("returns error on zero page size", func() {
+			_, err := snapshot.NewPublisher(mockRepo, mockProducer, snapshot.Options{})
+			gomega.Expect(err).ShouldNot(gomega.BeNil())
+		})
+
+		It("returns error on inverted id range", func() {
+			_, err := snapshot.NewPublisher(mockRepo, mockProducer, snapshot.Options{PageSize: 1, FromId: 5, ToId: 2})
+			gomega.Expect(err).ShouldNot(gomega.BeNil())
+		})
+	})
+
+	Context("when all teams are published", func() {
+		It("streams teams page by page", func() {
+			gomock.InOrder(
+				mockRepo.EXPECT().ListTeamsAfter(gomock.Any(), uint64(0), uint64(2)).Return(teams[:2], nil),
+				mockRepo.EXPECT().ListTeamsAfter(gomock.Any(), uint64(2), uint64(2)).Return(teams[2:], nil),
+			)
+			for _, team := range teams {
+				mockProducer.EXPECT().Send(kafka.NewSnapshotMessage(team)).Return(nil)
+			}
+
+			p, err := snapshot.NewPublisher(mockRepo, mockProducer, snapshot.Options{PageSize: 2, ProgressFile: progressFile})
+			gomega.Expect(err).Should(gomega.BeNil())
+
+			published, err := p.Publish(context.Background())
+			gomega.Expect(err).Should(gomega.BeNil())
+			gomega.Expect(published).Should(gomega.Equal(uint64(3)))
+			_, err = os.Stat(progressFile)
+			gomega.Expect(os.IsNotExist(err)).Should(gomega.BeTrue())
+		})
+
+		It("publishes all teams again on the next run", func() {
+			mockRepo.EXPECT().ListTeamsAfter(gomock.Any(), uint64(0), uint64(10)).Return(teams, nil).Times(2)
+			for _, team := range teams {
+				mockProducer.EXPECT().Send(kafka.NewSnapshotMessage(team)).Return(nil).Times(2)
+			}
+
+			p, err := snapshot.NewPublisher(mockRepo, mockProducer, snapshot.Options{PageSize: 10, ProgressFile: progressFile})
+			gomega.Expect(err).Should(gomega.BeNil())
+
+			for run := 0; run < 2; run++ {
+				published, err := p.Publish(context.Background())
+				gomega.Expect(err).Should(gomega.BeNil())
+				gomega.Expect(published).Should(gomega.Equal(uint64(3)))
+			}
+		})
+
+		It("stops at the end of id range", func() {
+			mockRepo.EXPECT().ListTeamsAfter(gomock.Any(), uint64(1), uint64(10)).Return(teams[1:], nil)
+			mockProducer.EXPECT().Send(kafka.NewSnapshotMessage(teams[1])).Return(nil)
+
+			p, err := snapshot.NewPublisher(mockRepo, mockProducer, snapshot.Options{PageSize: 10, FromId: 2, ToId: 4})
+			gomega.Expect(err).Should(gomega.BeNil())
+
+			published, err := p.Publish(context.Background())
+			gomega.Expect(err).Should(gomega.BeNil())
+			gomega.Expect(published).Should(gomega.Equal(uint64(1)))
+		})
+	})
+
+	Context("when publishing is interrupted", func() {
+		It("resumes after the last published team", func() {
+			mockRepo.EXPECT().ListTeamsAfter(gomock.Any(), uint64(0), uint64(10)).Return(teams, nil)
+			mockProducer.EXPECT().Send(kafka.NewSnapshotMessage(teams[0])).Return(nil)
+			mockProducer.EXPECT().Send(kafka.NewSnapshotMessage(teams[1])).Return(errors.New("broker is down"))
+
+			p, err := snapshot.NewPublisher(mockRepo, mockProducer, snapshot.Options{PageSize: 10, ProgressFile: progressFile})
+			gomega.Expect(err).Should(gomega.BeNil())
+
+			published, err := p.Publish(context.Background())
+			gomega.Expect(err).ShouldNot(gomega.BeNil())
+			gomega.Expect(published).Should(gomega.Equal(uint64(1)))
+
+			mockRepo.EXPECT().ListTeamsAfter(gomock.Any(), uint64(1), uint64(10)).Return(teams[1:], nil)
+			mockProducer.EXPECT().Send(gomock.Any()).Return(nil).Times(2)
+
+			published, err = p.Publish(context.Background())
+			gomega.Expect(err).Should(gomega.BeNil())
+			gomega.Expect(published).Should(gomega.Equal(uint64(2)))
+		})
+	})
+})
@@ -0,0 +1,31 @@
+package utils
+
+import (
+	"context"
+	"math/rand"
+	"time"
+)
+
+// Jitter is the method that randomizes backoff in range [d/2, d),
+// so retrying clients do not come back at the same moment.
+func Jitter(d time.Duration) time.Duration {
+	if d <= 1 {
+		return d
+	}
+
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)))
+}
+
+// Sleep is the method that waits for d unless ctx is done first.
+// It returns false if ctx is done.
+func Sleep(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
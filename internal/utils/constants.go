@@ -1,9 +1,12 @@
 package utils
 
-// SearchType is the type of Full Text Search (FTS): plaintext-oriented or phrase-oriented
+// SearchType is the type of Full Text Search (FTS): plaintext-oriented, phrase-oriented,
+// web search syntax (quotes, OR and minus) or prefixes of words for search-as-you-type
 type SearchType uint8
 
 const (
-	Plain  SearchType = 0
-	Phrase SearchType = 1
+	Plain     SearchType = 0
+	Phrase    SearchType = 1
+	Websearch SearchType = 2
+	Prefix    SearchType = 3
 )
@@ -44,3 +44,19 @@ func TeamsToMap(teams []models.Team) (map[uint64]models.Team, error) {
 
 	return teamsMap, nil
 }
+
+// TeamsToExternalIdMap is the method for converting []models.Team to map of models.Team keyed by external id.
+// It returns error if external ids are not unique.
+func TeamsToExternalIdMap(teams []models.Team) (map[string]models.Team, error) {
+	teamsMap := make(map[string]models.Team, len(teams))
+
+	for _, team := range teams {
+		if _, exists := teamsMap[team.ExternalId]; exists {
+			return nil, fmt.Errorf("duplicate external ids: team with external_id=%s already exists", team.ExternalId)
+		}
+
+		teamsMap[team.ExternalId] = team
+	}
+
+	return teamsMap, nil
+}
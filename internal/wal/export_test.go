@@ -0,0 +1,29 @@
+package wal
+
+import "errors"
+
+// failingSegment is the segmentFile that fails the write exceeding limit bytes once.
+type failingSegment struct {
+	segmentFile
+	limit int
+}
+
+func (s *failingSegment) Write(p []byte) (int, error) {
+	if s.limit < 0 || len(p) <= s.limit {
+		if s.limit >= 0 {
+			s.limit -= len(p)
+		}
+		return s.segmentFile.Write(p)
+	}
+
+	n, _ := s.segmentFile.Write(p[:s.limit])
+	s.limit = -1
+
+	return n, errors.New("no space left on device")
+}
+
+// FailWriteAfter is the method making the write of the current segment of w fail
+// after limit bytes, the following writes succeed.
+func FailWriteAfter(w *wal, limit int) {
+	w.segment = &failingSegment{segmentFile: w.segment, limit: limit}
+}
@@ -0,0 +1,445 @@
+package wal
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/ozoncp/ocp-team-api/internal/models"
+	"hash/crc32"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	segmentExt = ".wal"
+	headerSize = 8
+
+	// maxRecordSize protects replay from allocating huge buffers for garbage lengths.
+	maxRecordSize = 1 << 20
+
+	defaultSegmentSize = 64 << 20
+)
+
+// ErrCorrupted is returned by Replay when a segment contains invalid record.
+var ErrCorrupted = errors.New("wal segment is corrupted")
+
+var crcTable = crc32.MakeTable(crc32.Castagnoli)
+
+// SyncPolicy is the policy of flushing appended records to the stable storage.
+type SyncPolicy uint8
+
+const (
+	// SyncAlways calls fsync after every Append, so acknowledged teams survive power loss.
+	SyncAlways SyncPolicy = iota
+	// SyncInterval calls fsync periodically, so a crash loses at most one interval.
+	SyncInterval
+	// SyncNever leaves flushing to the operating system, so only process crashes are survived.
+	SyncNever
+)
+
+// Options is the struct representing settings of the write-ahead log.
+type Options struct {
+	SyncPolicy   SyncPolicy
+	SyncInterval time.Duration
+	SegmentSize  int64
+}
+
+// WAL is the interface of the write-ahead log for teams not yet persisted.
+type WAL interface {
+	Append(teams ...models.Team) error
+	Checkpoint(pending []models.Team) error
+	Replay() ([]models.Team, error)
+	Close() error
+}
+
+// segmentFile is the file of the current segment.
+type segmentFile interface {
+	io.WriteSeeker
+	Truncate(size int64) error
+	Sync() error
+	Close() error
+}
+
+// wal is the struct that implements WAL interface on top of segment files.
+// Every record is stored as: 4 bytes of payload length, 4 bytes of CRC-32C
+// of the payload and JSON encoded team as the payload.
+type wal struct {
+	mu      sync.Mutex
+	dir     string
+	options Options
+	segment segmentFile
+	seq     uint64
+	size    int64
+	dirty   bool
+	closed  bool
+	doneCh  chan struct{}
+}
+
+// Open is the constructor method for wal struct.
+// It creates the directory if it does not exist. Existing segments are kept
+// for Replay, new records are always appended to a new segment.
+func Open(dir string, options Options) (*wal, error) {
+	if options.SyncPolicy == SyncInterval && options.SyncInterval <= 0 {
+		return nil, errors.New("sync interval must be positive")
+	}
+
+	if options.SegmentSize <= 0 {
+		options.SegmentSize = defaultSegmentSize
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+
+	seqs, err := segments(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	w := &wal{dir: dir, options: options, doneCh: make(chan struct{})}
+	if len(seqs) > 0 {
+		w.seq = seqs[len(seqs)-1]
+	}
+
+	if err = w.rotate(); err != nil {
+		return nil, err
+	}
+
+	if options.SyncPolicy == SyncInterval {
+		go w.syncLoop()
+	}
+
+	return w, nil
+}
+
+// Append is the method that writes teams to the current segment.
+// All teams are synced at once according to the sync policy (group commit).
+// Teams are appended as a whole: if any write or sync fails, the segment is truncated
+// back, so no team of the failed call is replayed. The segment is rotated before
+// the call only, so it may exceed the segment size by the last group.
+func (w *wal) Append(teams ...models.Team) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.closed {
+		return errors.New("wal is closed")
+	}
+
+	if w.size >= w.options.SegmentSize {
+		if err := w.rotate(); err != nil {
+			return err
+		}
+	}
+
+	offset := w.size
+	if err := w.writeAll(teams); err != nil {
+		return w.truncate(offset, err)
+	}
+
+	if w.options.SyncPolicy == SyncAlways {
+		if err := w.sync(); err != nil {
+			return w.truncate(offset, err)
+		}
+	}
+
+	return nil
+}
+
+// Checkpoint is the method that drops every record written so far except pending teams.
+// It is called after successful flush: pending teams are rewritten to a new segment
+// and all older segments are removed.
+func (w *wal) Checkpoint(pending []models.Team) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.closed {
+		return errors.New("wal is closed")
+	}
+
+	if err := w.rotate(); err != nil {
+		return err
+	}
+
+	// Older segments are kept until pending teams are synced, so on failure
+	// the new segment is emptied to avoid replaying pending teams twice.
+	if err := w.writeAll(pending); err != nil {
+		return w.truncate(0, err)
+	}
+
+	if err := w.sync(); err != nil {
+		return w.truncate(0, err)
+	}
+
+	seqs, err := segments(w.dir)
+	if err != nil {
+		return err
+	}
+
+	for _, seq := range seqs {
+		if seq < w.seq {
+			if err = os.Remove(w.path(seq)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return syncDir(w.dir)
+}
+
+// Replay is the method for reading all teams stored in the log in order of appending.
+// Reading of a segment stops at the first invalid record: a torn record at the end of
+// the segment is expected after a crash and is skipped silently, any other invalid
+// record is reported with ErrCorrupted. Valid records are returned in both cases.
+func (w *wal) Replay() ([]models.Team, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	seqs, err := segments(w.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		teams      []models.Team
+		corruption error
+	)
+
+	for _, seq := range seqs {
+		segmentTeams, err := readSegment(w.path(seq))
+		teams = append(teams, segmentTeams...)
+
+		if errors.Is(err, ErrCorrupted) {
+			if corruption == nil {
+				corruption = err
+			}
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return teams, corruption
+}
+
+// Close is the method that syncs and closes the current segment.
+func (w *wal) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.closed {
+		return nil
+	}
+
+	w.closed = true
+	close(w.doneCh)
+
+	if err := w.sync(); err != nil {
+		return err
+	}
+
+	return w.segment.Close()
+}
+
+func (w *wal) syncLoop() {
+	ticker := time.NewTicker(w.options.SyncInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-w.doneCh:
+			return
+		case <-ticker.C:
+			w.mu.Lock()
+			if !w.closed {
+				_ = w.sync()
+			}
+			w.mu.Unlock()
+		}
+	}
+}
+
+// rotate is the method that closes the current segment and starts the next one.
+func (w *wal) rotate() error {
+	if w.segment != nil {
+		if err := w.sync(); err != nil {
+			return err
+		}
+		if err := w.segment.Close(); err != nil {
+			return err
+		}
+	}
+
+	w.seq++
+
+	f, err := os.OpenFile(w.path(w.seq), os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+
+	w.segment = f
+	w.size = 0
+
+	return syncDir(w.dir)
+}
+
+func (w *wal) writeAll(teams []models.Team) error {
+	for _, team := range teams {
+		if err := w.write(team); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// truncate is the method that drops records written to the current segment after offset
+// because of cause. If the segment cannot be truncated, it is abandoned for the new one.
+// It returns cause.
+func (w *wal) truncate(offset int64, cause error) error {
+	err := w.segment.Truncate(offset)
+	if err == nil {
+		_, err = w.segment.Seek(offset, io.SeekStart)
+	}
+	if err == nil {
+		w.size = offset
+		return cause
+	}
+
+	if rotateErr := w.rotate(); rotateErr != nil {
+		return fmt.Errorf("%v, cannot truncate segment: %v, cannot rotate segment: %v", cause, err, rotateErr)
+	}
+
+	return fmt.Errorf("%v, cannot truncate segment: %v", cause, err)
+}
+
+func (w *wal) write(team models.Team) error {
+	payload, err := json.Marshal(team)
+	if err != nil {
+		return err
+	}
+
+	record := make([]byte, headerSize+len(payload))
+	binary.BigEndian.PutUint32(record[0:4], uint32(len(payload)))
+	binary.BigEndian.PutUint32(record[4:8], crc32.Checksum(payload, crcTable))
+	copy(record[headerSize:], payload)
+
+	n, err := w.segment.Write(record)
+	w.size += int64(n)
+	w.dirty = true
+
+	return err
+}
+
+func (w *wal) sync() error {
+	if !w.dirty || w.options.SyncPolicy == SyncNever {
+		return nil
+	}
+
+	w.dirty = false
+
+	return w.segment.Sync()
+}
+
+func (w *wal) path(seq uint64) string {
+	return filepath.Join(w.dir, fmt.Sprintf("%020d%s", seq, segmentExt))
+}
+
+// readSegment is the method for reading all valid records of the segment.
+func readSegment(path string) ([]models.Team, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var (
+		teams  []models.Team
+		offset int64
+		header = make([]byte, headerSize)
+		reader = bufio.NewReader(f)
+	)
+
+	for {
+		if _, err = io.ReadFull(reader, header); err == io.EOF {
+			return teams, nil
+		} else if err == io.ErrUnexpectedEOF {
+			return teams, nil
+		} else if err != nil {
+			return teams, err
+		}
+
+		length := binary.BigEndian.Uint32(header[0:4])
+		checksum := binary.BigEndian.Uint32(header[4:8])
+
+		if length > maxRecordSize {
+			return teams, fmt.Errorf("%w: %s at offset %d: invalid record length %d", ErrCorrupted, path, offset, length)
+		}
+
+		payload := make([]byte, length)
+		if _, err = io.ReadFull(reader, payload); err == io.EOF || err == io.ErrUnexpectedEOF {
+			return teams, nil
+		} else if err != nil {
+			return teams, err
+		}
+
+		if crc32.Checksum(payload, crcTable) != checksum {
+			return teams, fmt.Errorf("%w: %s at offset %d: checksum mismatch", ErrCorrupted, path, offset)
+		}
+
+		var team models.Team
+		if err = json.Unmarshal(payload, &team); err != nil {
+			return teams, fmt.Errorf("%w: %s at offset %d: %v", ErrCorrupted, path, offset, err)
+		}
+
+		teams = append(teams, team)
+		offset += int64(headerSize) + int64(length)
+	}
+}
+
+// segments is the method for listing sequence numbers of segments in ascending order.
+func segments(dir string) ([]uint64, error) {
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var seqs []uint64
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, segmentExt) {
+			continue
+		}
+
+		seq, err := strconv.ParseUint(strings.TrimSuffix(name, segmentExt), 10, 64)
+		if err != nil {
+			continue
+		}
+
+		seqs = append(seqs, seq)
+	}
+
+	sort.Slice(seqs, func(i, j int) bool { return seqs[i] < seqs[j] })
+
+	return seqs, nil
+}
+
+// syncDir is the method that makes creation and removal of segments durable.
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+
+	return d.Sync()
+}
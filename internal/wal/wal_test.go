@@ -0,0 +1,136 @@
+package wal_test
+
+import (
+	"errors"
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/gomega"
+	"github.com/ozoncp/ocp-team-api/internal/models"
+	"github.com/ozoncp/ocp-team-api/internal/wal"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+var _ = Describe("WAL", func() {
+	var dir string
+
+	teams := []models.Team{
+		{Id: 1, Name: "Team1", Description: "Desc1"},
+		{Id: 2, Name: "Team2", Description: "Desc2"},
+		{Id: 3, Name: "Team3", Description: "Desc3"},
+	}
+
+	// segmentFiles is the helper listing segment files in the log directory.
+	segmentFiles := func() []string {
+		files, err := filepath.Glob(filepath.Join(dir, "*.wal"))
+		gomega.Expect(err).Should(gomega.BeNil())
+		return files
+	}
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "wal")
+		gomega.Expect(err).Should(gomega.BeNil())
+	})
+
+	AfterEach(func() {
+		_ = os.RemoveAll(dir)
+	})
+
+	Context("invalid options", func() {
+		It("returns error for interval policy without interval", func() {
+			_, err := wal.Open(dir, wal.Options{SyncPolicy: wal.SyncInterval})
+			gomega.Expect(err).ShouldNot(gomega.BeNil())
+		})
+	})
+
+	Context("when log is reopened", func() {
+		It("replays appended teams in order", func() {
+			w, err := wal.Open(dir, wal.Options{SegmentSize: 64})
+			gomega.Expect(err).Should(gomega.BeNil())
+			gomega.Expect(w.Append(teams[0])).Should(gomega.Succeed())
+			gomega.Expect(w.Append(teams[1:]...)).Should(gomega.Succeed())
+			gomega.Expect(w.Close()).Should(gomega.Succeed())
+			gomega.Expect(len(segmentFiles())).Should(gomega.BeNumerically(">", 1))
+
+			w, err = wal.Open(dir, wal.Options{SyncPolicy: wal.SyncInterval, SyncInterval: time.Millisecond})
+			gomega.Expect(err).Should(gomega.BeNil())
+			defer w.Close()
+
+			gomega.Expect(w.Replay()).Should(gomega.Equal(teams))
+		})
+	})
+
+	Context("when log is checkpointed", func() {
+		It("keeps only pending teams", func() {
+			w, err := wal.Open(dir, wal.Options{})
+			gomega.Expect(err).Should(gomega.BeNil())
+			defer w.Close()
+
+			gomega.Expect(w.Append(teams...)).Should(gomega.Succeed())
+			gomega.Expect(w.Checkpoint(teams[2:])).Should(gomega.Succeed())
+
+			gomega.Expect(segmentFiles()).Should(gomega.HaveLen(1))
+			gomega.Expect(w.Replay()).Should(gomega.Equal(teams[2:]))
+		})
+	})
+
+	Context("when write fails", func() {
+		It("does not replay any team of the failed append", func() {
+			w, err := wal.Open(dir, wal.Options{})
+			gomega.Expect(err).Should(gomega.BeNil())
+			defer w.Close()
+
+			gomega.Expect(w.Append(teams[0])).Should(gomega.Succeed())
+
+			wal.FailWriteAfter(w, 100)
+			gomega.Expect(w.Append(teams[1:]...)).ShouldNot(gomega.Succeed())
+			gomega.Expect(w.Append(teams[2])).Should(gomega.Succeed())
+
+			gomega.Expect(w.Replay()).Should(gomega.Equal([]models.Team{teams[0], teams[2]}))
+		})
+	})
+
+	Context("when segment is damaged", func() {
+		var path string
+
+		BeforeEach(func() {
+			w, err := wal.Open(dir, wal.Options{})
+			gomega.Expect(err).Should(gomega.BeNil())
+			gomega.Expect(w.Append(teams...)).Should(gomega.Succeed())
+			gomega.Expect(w.Close()).Should(gomega.Succeed())
+
+			files := segmentFiles()
+			gomega.Expect(files).Should(gomega.HaveLen(1))
+			path = files[0]
+		})
+
+		It("skips torn record at the tail", func() {
+			info, err := os.Stat(path)
+			gomega.Expect(err).Should(gomega.BeNil())
+			gomega.Expect(os.Truncate(path, info.Size()-3)).Should(gomega.Succeed())
+
+			w, err := wal.Open(dir, wal.Options{})
+			gomega.Expect(err).Should(gomega.BeNil())
+			defer w.Close()
+
+			gomega.Expect(w.Replay()).Should(gomega.Equal(teams[:2]))
+		})
+
+		It("detects checksum mismatch", func() {
+			b, err := ioutil.ReadFile(path)
+			gomega.Expect(err).Should(gomega.BeNil())
+			b[len(b)-2] ^= 0xff
+			gomega.Expect(ioutil.WriteFile(path, b, 0644)).Should(gomega.Succeed())
+
+			w, err := wal.Open(dir, wal.Options{})
+			gomega.Expect(err).Should(gomega.BeNil())
+			defer w.Close()
+
+			replayed, err := w.Replay()
+			gomega.Expect(errors.Is(err, wal.ErrCorrupted)).Should(gomega.BeTrue())
+			gomega.Expect(replayed).Should(gomega.Equal(teams[:2]))
+		})
+	})
+})
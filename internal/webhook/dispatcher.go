@@ -0,0 +1,231 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"github.com/ozoncp/ocp-team-api/internal/kafka"
+	"github.com/ozoncp/ocp-team-api/internal/models"
+	"github.com/ozoncp/ocp-team-api/internal/repo"
+	"github.com/ozoncp/ocp-team-api/internal/utils"
+	"github.com/rs/zerolog/log"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	// SignatureHeader is the header carrying HMAC-SHA256 signature of the payload.
+	SignatureHeader = "X-Ocp-Signature"
+	// EventHeader is the header carrying the event name.
+	EventHeader = "X-Ocp-Event"
+
+	userAgent = "ocp-team-api-webhook"
+)
+
+// Settings is the struct representing delivery settings of the dispatcher.
+type Settings struct {
+	Workers        int
+	QueueSize      int
+	Timeout        time.Duration
+	MaxAttempts    uint32
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	DisableAfter   uint32
+}
+
+// Dispatcher is the interface for delivering team events to webhook subscriptions.
+type Dispatcher interface {
+	Dispatch(message kafka.Message)
+	Run(ctx context.Context) error
+}
+
+// dispatcher is the struct that implements Dispatcher interface.
+// Events are queued and delivered by the pool of workers started with Run.
+type dispatcher struct {
+	repo     repo.WebhookRepo
+	client   *http.Client
+	settings Settings
+	queue    chan kafka.Message
+}
+
+// NewDispatcher is the constructor method for dispatcher struct.
+func NewDispatcher(repo repo.WebhookRepo, settings Settings) *dispatcher {
+	if settings.Workers <= 0 {
+		settings.Workers = 1
+	}
+
+	if settings.MaxAttempts == 0 {
+		settings.MaxAttempts = 1
+	}
+
+	if settings.MaxBackoff < settings.InitialBackoff {
+		settings.MaxBackoff = settings.InitialBackoff
+	}
+
+	return &dispatcher{
+		repo:     repo,
+		client:   &http.Client{Timeout: settings.Timeout},
+		settings: settings,
+		queue:    make(chan kafka.Message, settings.QueueSize),
+	}
+}
+
+// Dispatch is the method that enqueues the event for delivery.
+// It never blocks: the event is dropped when the queue is full.
+func (d *dispatcher) Dispatch(message kafka.Message) {
+	select {
+	case d.queue <- message:
+	default:
+		log.Warn().Msgf("webhook queue is full, event %s of team %d is dropped", message.Event, message.Id)
+	}
+}
+
+// Run is the method that starts delivery workers and blocks until ctx is done.
+func (d *dispatcher) Run(ctx context.Context) error {
+	var wg sync.WaitGroup
+
+	for i := 0; i < d.settings.Workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case message := <-d.queue:
+					d.handle(ctx, message)
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	return nil
+}
+
+// handle is the method that delivers the event to every enabled subscription
+// interested in it.
+func (d *dispatcher) handle(ctx context.Context, message kafka.Message) {
+	webhooks, err := d.repo.ListWebhooks(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("cannot list webhooks")
+		return
+	}
+
+	body, err := json.Marshal(message)
+	if err != nil {
+		log.Error().Err(err).Msg("cannot marshal webhook payload")
+		return
+	}
+
+	for _, webhook := range webhooks {
+		if !webhook.IsEnabled || !webhook.Accepts(message.Event) {
+			continue
+		}
+
+		d.deliver(ctx, webhook, message, body)
+	}
+}
+
+// deliver is the method that posts the payload to the subscription retrying
+// with exponential backoff, and records the outcome in the delivery log.
+func (d *dispatcher) deliver(ctx context.Context, webhook models.Webhook, message kafka.Message, body []byte) {
+	delivery := models.WebhookDelivery{
+		WebhookId: webhook.Id,
+		Event:     message.Event,
+		TeamId:    message.Id,
+	}
+
+	backoff := d.settings.InitialBackoff
+
+	for attempt := uint32(1); attempt <= d.settings.MaxAttempts; attempt++ {
+		delivery.Attempts = attempt
+
+		statusCode, retryable, err := d.post(ctx, webhook, message.Event, body)
+		delivery.StatusCode = statusCode
+		if err == nil {
+			delivery.Success = true
+			delivery.Error = ""
+			break
+		}
+
+		delivery.Error = err.Error()
+		if !retryable || attempt == d.settings.MaxAttempts || !utils.Sleep(ctx, utils.Jitter(backoff)) {
+			break
+		}
+
+		if backoff *= 2; backoff > d.settings.MaxBackoff {
+			backoff = d.settings.MaxBackoff
+		}
+	}
+
+	// The outcome is recorded even after ctx is done, so the log stays complete on shutdown.
+	storeCtx := context.Background()
+
+	if delivery.Success {
+		if err := d.repo.ResetFailures(storeCtx, webhook.Id); err != nil {
+			log.Error().Err(err).Msgf("cannot reset failures of webhook %d", webhook.Id)
+		}
+	} else {
+		disabled, err := d.repo.RegisterFailure(storeCtx, webhook.Id, d.settings.DisableAfter)
+		if err != nil {
+			log.Error().Err(err).Msgf("cannot register failure of webhook %d", webhook.Id)
+		}
+		if disabled {
+			log.Warn().Msgf("webhook %d is disabled after repeated failures", webhook.Id)
+		}
+	}
+
+	if err := d.repo.AddDelivery(storeCtx, &delivery); err != nil {
+		log.Error().Err(err).Msgf("cannot store delivery of webhook %d", webhook.Id)
+	}
+}
+
+// post is the method that makes single delivery attempt.
+// It returns the response status code and whether the failure is worth retrying.
+func (d *dispatcher) post(ctx context.Context, webhook models.Webhook, event string, body []byte) (int, bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, false, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", userAgent)
+	req.Header.Set(EventHeader, event)
+	req.Header.Set(SignatureHeader, Sign(webhook.Secret, body))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, true, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return resp.StatusCode, false, nil
+	}
+
+	retryable := resp.StatusCode >= 500 ||
+		resp.StatusCode == http.StatusRequestTimeout ||
+		resp.StatusCode == http.StatusTooManyRequests
+
+	return resp.StatusCode, retryable, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+}
+
+// Sign is the method for calculating the value of SignatureHeader.
+// Receivers verify the payload by calculating the same value with the shared secret.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
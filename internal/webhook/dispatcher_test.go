@@ -0,0 +1,140 @@
+package webhook_test
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/gomega"
+	"github.com/ozoncp/ocp-team-api/internal/kafka"
+	"github.com/ozoncp/ocp-team-api/internal/mocks"
+	"github.com/ozoncp/ocp-team-api/internal/models"
+	"github.com/ozoncp/ocp-team-api/internal/webhook"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"time"
+)
+
+var _ = Describe("Dispatcher", func() {
+	var (
+		ctrl            *gomock.Controller
+		mockWebhookRepo *mocks.MockWebhookRepo
+		mockProducer    *mocks.MockProducer
+		server          *httptest.Server
+		statusCode      int32
+		requests        int32
+		cancel          context.CancelFunc
+		deliveries      chan models.WebhookDelivery
+		p               kafka.Producer
+	)
+
+	secret := "0123456789abcdef"
+	message := kafka.NewMessage(42, kafka.Create)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockWebhookRepo = mocks.NewMockWebhookRepo(ctrl)
+		mockProducer = mocks.NewMockProducer(ctrl)
+		deliveries = make(chan models.WebhookDelivery, 1)
+		atomic.StoreInt32(&requests, 0)
+
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&requests, 1)
+
+			body, _ := ioutil.ReadAll(r.Body)
+			if r.Header.Get(webhook.SignatureHeader) != webhook.Sign(secret, body) {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+
+			var received kafka.Message
+			if err := json.Unmarshal(body, &received); err != nil || received != message {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+
+			w.WriteHeader(int(atomic.LoadInt32(&statusCode)))
+		}))
+
+		mockWebhookRepo.EXPECT().ListWebhooks(gomock.Any()).Return([]models.Webhook{
+			{Id: 1, URL: server.URL, Secret: secret, IsEnabled: true, Events: []string{"Create"}},
+			{Id: 2, URL: server.URL, Secret: secret, IsEnabled: true, Events: []string{"Delete"}},
+			{Id: 3, URL: server.URL, Secret: secret, IsEnabled: false},
+		}, nil)
+		mockWebhookRepo.EXPECT().AddDelivery(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, delivery *models.WebhookDelivery) error {
+				deliveries <- *delivery
+				return nil
+			})
+		mockProducer.EXPECT().Send(message).Return(nil)
+
+		d := webhook.NewDispatcher(mockWebhookRepo, webhook.Settings{
+			Workers:        1,
+			QueueSize:      10,
+			Timeout:        time.Second,
+			MaxAttempts:    3,
+			InitialBackoff: time.Millisecond,
+			MaxBackoff:     5 * time.Millisecond,
+			DisableAfter:   5,
+		})
+
+		var ctx context.Context
+		ctx, cancel = context.WithCancel(context.Background())
+		go func() { _ = d.Run(ctx) }()
+
+		p = webhook.NewProducer(mockProducer, d)
+	})
+
+	AfterEach(func() {
+		cancel()
+		server.Close()
+		ctrl.Finish()
+	})
+
+	Context("when subscriber accepts the event", func() {
+		It("delivers signed payload once and resets failures", func() {
+			atomic.StoreInt32(&statusCode, http.StatusNoContent)
+			mockWebhookRepo.EXPECT().ResetFailures(gomock.Any(), uint64(1)).Return(nil)
+
+			gomega.Expect(p.Send(message)).Should(gomega.Succeed())
+
+			var delivery models.WebhookDelivery
+			gomega.Eventually(deliveries, time.Second).Should(gomega.Receive(&delivery))
+			gomega.Expect(delivery.WebhookId).Should(gomega.Equal(uint64(1)))
+			gomega.Expect(delivery.Success).Should(gomega.BeTrue())
+			gomega.Expect(delivery.Attempts).Should(gomega.Equal(uint32(1)))
+			gomega.Expect(delivery.StatusCode).Should(gomega.Equal(http.StatusNoContent))
+			gomega.Expect(atomic.LoadInt32(&requests)).Should(gomega.Equal(int32(1)))
+		})
+	})
+
+	Context("when subscriber keeps failing", func() {
+		It("retries and registers failure", func() {
+			atomic.StoreInt32(&statusCode, http.StatusServiceUnavailable)
+			mockWebhookRepo.EXPECT().RegisterFailure(gomock.Any(), uint64(1), uint32(5)).Return(false, nil)
+
+			gomega.Expect(p.Send(message)).Should(gomega.Succeed())
+
+			var delivery models.WebhookDelivery
+			gomega.Eventually(deliveries, time.Second).Should(gomega.Receive(&delivery))
+			gomega.Expect(delivery.Success).Should(gomega.BeFalse())
+			gomega.Expect(delivery.Attempts).Should(gomega.Equal(uint32(3)))
+			gomega.Expect(delivery.Error).ShouldNot(gomega.BeEmpty())
+			gomega.Expect(atomic.LoadInt32(&requests)).Should(gomega.Equal(int32(3)))
+		})
+
+		It("does not retry permanent failures", func() {
+			atomic.StoreInt32(&statusCode, http.StatusGone)
+			mockWebhookRepo.EXPECT().RegisterFailure(gomock.Any(), uint64(1), uint32(5)).Return(true, nil)
+
+			gomega.Expect(p.Send(message)).Should(gomega.Succeed())
+
+			var delivery models.WebhookDelivery
+			gomega.Eventually(deliveries, time.Second).Should(gomega.Receive(&delivery))
+			gomega.Expect(delivery.Attempts).Should(gomega.Equal(uint32(1)))
+			gomega.Expect(delivery.StatusCode).Should(gomega.Equal(http.StatusGone))
+		})
+	})
+})
@@ -0,0 +1,27 @@
+package webhook
+
+import "github.com/ozoncp/ocp-team-api/internal/kafka"
+
+// producer is the struct that implements kafka.Producer interface.
+// It passes every message to the next producer and to the webhook dispatcher,
+// so webhook subscribers receive the same events as the broker.
+type producer struct {
+	next       kafka.Producer
+	dispatcher Dispatcher
+}
+
+// NewProducer is the constructor method for producer struct.
+func NewProducer(next kafka.Producer, dispatcher Dispatcher) *producer {
+	return &producer{
+		next:       next,
+		dispatcher: dispatcher,
+	}
+}
+
+// Send is the method that dispatches message to webhooks and sends it to the broker.
+// Webhook delivery does not depend on the result of sending to the broker.
+func (p *producer) Send(message kafka.Message) error {
+	p.dispatcher.Dispatch(message)
+
+	return p.next.Send(message)
+}
@@ -0,0 +1,40 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE webhook(
+    id  SERIAL PRIMARY KEY,
+    url TEXT NOT NULL,
+    events TEXT NOT NULL DEFAULT '',
+    secret TEXT NOT NULL,
+    is_enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    failures INTEGER NOT NULL DEFAULT 0
+);
+
+COMMENT ON COLUMN webhook.id IS 'The ID of webhook subscription';
+COMMENT ON COLUMN webhook.url IS 'The URL events are posted to';
+COMMENT ON COLUMN webhook.events IS 'Comma separated event filter, empty means all events';
+COMMENT ON COLUMN webhook.secret IS 'The secret for HMAC signature of payloads';
+COMMENT ON COLUMN webhook.is_enabled IS 'The flag of active subscription';
+COMMENT ON COLUMN webhook.failures IS 'The number of consecutive failed deliveries';
+
+CREATE TABLE webhook_delivery(
+    id  SERIAL PRIMARY KEY,
+    webhook_id INTEGER NOT NULL REFERENCES webhook(id) ON DELETE CASCADE,
+    event VARCHAR(32) NOT NULL,
+    team_id BIGINT NOT NULL,
+    success BOOLEAN NOT NULL,
+    attempts INTEGER NOT NULL,
+    status_code INTEGER NOT NULL DEFAULT 0,
+    error TEXT NOT NULL DEFAULT '',
+    delivered_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+COMMENT ON TABLE webhook_delivery IS 'The log of webhook deliveries';
+
+CREATE INDEX ix_webhook_delivery_webhook_id ON webhook_delivery(webhook_id, id DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE webhook_delivery;
+DROP TABLE webhook;
+-- +goose StatementEnd
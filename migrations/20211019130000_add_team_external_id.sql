@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE team ADD COLUMN external_id VARCHAR(255);
+
+COMMENT ON COLUMN team.external_id IS 'The ID of team in the external system it is imported from';
+
+CREATE UNIQUE INDEX ux_team_external_id ON team(external_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX ux_team_external_id;
+ALTER TABLE team DROP COLUMN external_id RESTRICT;
+-- +goose StatementEnd
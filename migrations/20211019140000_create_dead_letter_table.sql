@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE dead_letter(
+    id  SERIAL PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    error TEXT NOT NULL DEFAULT '',
+    attempts INTEGER NOT NULL DEFAULT 1,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+COMMENT ON TABLE dead_letter IS 'The teams that could not be persisted by the saver';
+COMMENT ON COLUMN dead_letter.error IS 'The last error the team failed with';
+COMMENT ON COLUMN dead_letter.attempts IS 'The number of failed attempts to persist the team';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE dead_letter;
+-- +goose StatementEnd
@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE INDEX ix_team_name_prefix ON team (lower(name) text_pattern_ops) WHERE is_deleted = FALSE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX ix_team_name_prefix;
+-- +goose StatementEnd
@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE dead_letter ADD COLUMN team_id BIGINT;
+ALTER TABLE dead_letter ADD COLUMN external_id VARCHAR(255);
+ALTER TABLE dead_letter ADD COLUMN sinks TEXT NOT NULL DEFAULT '';
+
+COMMENT ON COLUMN dead_letter.team_id IS 'The ID of the team created but not written to the sinks';
+COMMENT ON COLUMN dead_letter.sinks IS 'The comma-separated names of the sinks the created team is not written to';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE dead_letter DROP COLUMN sinks RESTRICT;
+ALTER TABLE dead_letter DROP COLUMN external_id RESTRICT;
+ALTER TABLE dead_letter DROP COLUMN team_id RESTRICT;
+-- +goose StatementEnd
@@ -0,0 +1,10 @@
+// Package migrations embeds the goose migrations of the database schema,
+// postgres ones are in the root directory and sqlite ones are in the sqlite directory.
+package migrations
+
+import "embed"
+
+// FS is the file system of the embedded migrations.
+//
+//go:embed *.sql sqlite/*.sql
+var FS embed.FS
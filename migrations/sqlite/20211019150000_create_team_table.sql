@@ -0,0 +1,54 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE team(
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name VARCHAR(100) NOT NULL,
+    description TEXT NOT NULL,
+    external_id VARCHAR(255) UNIQUE,
+    is_deleted BOOLEAN NOT NULL DEFAULT FALSE
+);
+-- +goose StatementEnd
+
+-- team_fts indexes name and description of the teams which are not deleted.
+-- +goose StatementBegin
+CREATE VIRTUAL TABLE team_fts USING fts5(
+    name,
+    description,
+    content = 'team',
+    content_rowid = 'id',
+    tokenize = 'porter unicode61'
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER team_fts_insert AFTER INSERT ON team WHEN NOT NEW.is_deleted
+BEGIN
+    INSERT INTO team_fts(rowid, name, description) VALUES (NEW.id, NEW.name, NEW.description);
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER team_fts_update AFTER UPDATE ON team
+BEGIN
+    INSERT INTO team_fts(team_fts, rowid, name, description)
+        SELECT 'delete', OLD.id, OLD.name, OLD.description WHERE NOT OLD.is_deleted;
+    INSERT INTO team_fts(rowid, name, description)
+        SELECT NEW.id, NEW.name, NEW.description WHERE NOT NEW.is_deleted;
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER team_fts_delete AFTER DELETE ON team WHEN NOT OLD.is_deleted
+BEGIN
+    INSERT INTO team_fts(team_fts, rowid, name, description) VALUES ('delete', OLD.id, OLD.name, OLD.description);
+END;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE team_fts;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP TABLE team;
+-- +goose StatementEnd
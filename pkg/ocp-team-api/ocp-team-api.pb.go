@@ -9,6 +9,7 @@ package ocp_team_api
 import (
 	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
+	longrunning "google.golang.org/genproto/googleapis/longrunning"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -22,11 +23,64 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type UpsertTeamsV1Response_Status int32
+
+const (
+	UpsertTeamsV1Response_UNCHANGED UpsertTeamsV1Response_Status = 0
+	UpsertTeamsV1Response_CREATED   UpsertTeamsV1Response_Status = 1
+	UpsertTeamsV1Response_UPDATED   UpsertTeamsV1Response_Status = 2
+)
+
+// Enum value maps for UpsertTeamsV1Response_Status.
+var (
+	UpsertTeamsV1Response_Status_name = map[int32]string{
+		0: "UNCHANGED",
+		1: "CREATED",
+		2: "UPDATED",
+	}
+	UpsertTeamsV1Response_Status_value = map[string]int32{
+		"UNCHANGED": 0,
+		"CREATED":   1,
+		"UPDATED":   2,
+	}
+)
+
+func (x UpsertTeamsV1Response_Status) Enum() *UpsertTeamsV1Response_Status {
+	p := new(UpsertTeamsV1Response_Status)
+	*p = x
+	return p
+}
+
+func (x UpsertTeamsV1Response_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UpsertTeamsV1Response_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_ocp_team_api_ocp_team_api_proto_enumTypes[0].Descriptor()
+}
+
+func (UpsertTeamsV1Response_Status) Type() protoreflect.EnumType {
+	return &file_api_ocp_team_api_ocp_team_api_proto_enumTypes[0]
+}
+
+func (x UpsertTeamsV1Response_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UpsertTeamsV1Response_Status.Descriptor instead.
+func (UpsertTeamsV1Response_Status) EnumDescriptor() ([]byte, []int) {
+	return file_api_ocp_team_api_ocp_team_api_proto_rawDescGZIP(), []int{5, 0}
+}
+
 type SearchTeamV1Request_Type int32
 
 const (
 	SearchTeamV1Request_PLAIN  SearchTeamV1Request_Type = 0
 	SearchTeamV1Request_PHRASE SearchTeamV1Request_Type = 1
+	// WEBSEARCH supports "quoted phrases", OR and -excluded words.
+	SearchTeamV1Request_WEBSEARCH SearchTeamV1Request_Type = 2
+	// PREFIX matches words starting with the words of the query.
+	SearchTeamV1Request_PREFIX SearchTeamV1Request_Type = 3
 )
 
 // Enum value maps for SearchTeamV1Request_Type.
@@ -34,10 +88,14 @@ var (
 	SearchTeamV1Request_Type_name = map[int32]string{
 		0: "PLAIN",
 		1: "PHRASE",
+		2: "WEBSEARCH",
+		3: "PREFIX",
 	}
 	SearchTeamV1Request_Type_value = map[string]int32{
-		"PLAIN":  0,
-		"PHRASE": 1,
+		"PLAIN":     0,
+		"PHRASE":    1,
+		"WEBSEARCH": 2,
+		"PREFIX":    3,
 	}
 )
 
@@ -52,11 +110,11 @@ func (x SearchTeamV1Request_Type) String() string {
 }
 
 func (SearchTeamV1Request_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_ocp_team_api_ocp_team_api_proto_enumTypes[0].Descriptor()
+	return file_api_ocp_team_api_ocp_team_api_proto_enumTypes[1].Descriptor()
 }
 
 func (SearchTeamV1Request_Type) Type() protoreflect.EnumType {
-	return &file_api_ocp_team_api_ocp_team_api_proto_enumTypes[0]
+	return &file_api_ocp_team_api_ocp_team_api_proto_enumTypes[1]
 }
 
 func (x SearchTeamV1Request_Type) Number() protoreflect.EnumNumber {
@@ -65,7 +123,56 @@ func (x SearchTeamV1Request_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SearchTeamV1Request_Type.Descriptor instead.
 func (SearchTeamV1Request_Type) EnumDescriptor() ([]byte, []int) {
-	return file_api_ocp_team_api_ocp_team_api_proto_rawDescGZIP(), []int{12, 0}
+	return file_api_ocp_team_api_ocp_team_api_proto_rawDescGZIP(), []int{14, 0}
+}
+
+type OperationMetadataV1_State int32
+
+const (
+	OperationMetadataV1_PENDING OperationMetadataV1_State = 0
+	OperationMetadataV1_DONE    OperationMetadataV1_State = 1
+	OperationMetadataV1_FAILED  OperationMetadataV1_State = 2
+)
+
+// Enum value maps for OperationMetadataV1_State.
+var (
+	OperationMetadataV1_State_name = map[int32]string{
+		0: "PENDING",
+		1: "DONE",
+		2: "FAILED",
+	}
+	OperationMetadataV1_State_value = map[string]int32{
+		"PENDING": 0,
+		"DONE":    1,
+		"FAILED":  2,
+	}
+)
+
+func (x OperationMetadataV1_State) Enum() *OperationMetadataV1_State {
+	p := new(OperationMetadataV1_State)
+	*p = x
+	return p
+}
+
+func (x OperationMetadataV1_State) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OperationMetadataV1_State) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_ocp_team_api_ocp_team_api_proto_enumTypes[2].Descriptor()
+}
+
+func (OperationMetadataV1_State) Type() protoreflect.EnumType {
+	return &file_api_ocp_team_api_ocp_team_api_proto_enumTypes[2]
+}
+
+func (x OperationMetadataV1_State) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OperationMetadataV1_State.Descriptor instead.
+func (OperationMetadataV1_State) EnumDescriptor() ([]byte, []int) {
+	return file_api_ocp_team_api_ocp_team_api_proto_rawDescGZIP(), []int{18, 0}
 }
 
 type CreateTeamV1Request struct {
@@ -264,6 +371,100 @@ func (x *MultiCreateTeamV1Response) GetIds() []uint64 {
 	return nil
 }
 
+type UpsertTeamsV1Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Teams []*UpsertTeamsV1Request_Team `protobuf:"bytes,1,rep,name=teams,proto3" json:"teams,omitempty"`
+}
+
+func (x *UpsertTeamsV1Request) Reset() {
+	*x = UpsertTeamsV1Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpsertTeamsV1Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertTeamsV1Request) ProtoMessage() {}
+
+func (x *UpsertTeamsV1Request) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertTeamsV1Request.ProtoReflect.Descriptor instead.
+func (*UpsertTeamsV1Request) Descriptor() ([]byte, []int) {
+	return file_api_ocp_team_api_ocp_team_api_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UpsertTeamsV1Request) GetTeams() []*UpsertTeamsV1Request_Team {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+type UpsertTeamsV1Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results []*UpsertTeamsV1Response_Result `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *UpsertTeamsV1Response) Reset() {
+	*x = UpsertTeamsV1Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpsertTeamsV1Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertTeamsV1Response) ProtoMessage() {}
+
+func (x *UpsertTeamsV1Response) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertTeamsV1Response.ProtoReflect.Descriptor instead.
+func (*UpsertTeamsV1Response) Descriptor() ([]byte, []int) {
+	return file_api_ocp_team_api_ocp_team_api_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpsertTeamsV1Response) GetResults() []*UpsertTeamsV1Response_Result {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
 type GetTeamV1Request struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -275,7 +476,7 @@ type GetTeamV1Request struct {
 func (x *GetTeamV1Request) Reset() {
 	*x = GetTeamV1Request{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[4]
+		mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -288,7 +489,7 @@ func (x *GetTeamV1Request) String() string {
 func (*GetTeamV1Request) ProtoMessage() {}
 
 func (x *GetTeamV1Request) ProtoReflect() protoreflect.Message {
-	mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[4]
+	mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -301,7 +502,7 @@ func (x *GetTeamV1Request) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTeamV1Request.ProtoReflect.Descriptor instead.
 func (*GetTeamV1Request) Descriptor() ([]byte, []int) {
-	return file_api_ocp_team_api_ocp_team_api_proto_rawDescGZIP(), []int{4}
+	return file_api_ocp_team_api_ocp_team_api_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetTeamV1Request) GetId() uint64 {
@@ -322,7 +523,7 @@ type GetTeamV1Response struct {
 func (x *GetTeamV1Response) Reset() {
 	*x = GetTeamV1Response{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[5]
+		mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -335,7 +536,7 @@ func (x *GetTeamV1Response) String() string {
 func (*GetTeamV1Response) ProtoMessage() {}
 
 func (x *GetTeamV1Response) ProtoReflect() protoreflect.Message {
-	mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[5]
+	mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -348,7 +549,7 @@ func (x *GetTeamV1Response) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTeamV1Response.ProtoReflect.Descriptor instead.
 func (*GetTeamV1Response) Descriptor() ([]byte, []int) {
-	return file_api_ocp_team_api_ocp_team_api_proto_rawDescGZIP(), []int{5}
+	return file_api_ocp_team_api_ocp_team_api_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GetTeamV1Response) GetTeam() *Team {
@@ -370,7 +571,7 @@ type ListTeamsV1Request struct {
 func (x *ListTeamsV1Request) Reset() {
 	*x = ListTeamsV1Request{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[6]
+		mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -383,7 +584,7 @@ func (x *ListTeamsV1Request) String() string {
 func (*ListTeamsV1Request) ProtoMessage() {}
 
 func (x *ListTeamsV1Request) ProtoReflect() protoreflect.Message {
-	mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[6]
+	mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -396,7 +597,7 @@ func (x *ListTeamsV1Request) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListTeamsV1Request.ProtoReflect.Descriptor instead.
 func (*ListTeamsV1Request) Descriptor() ([]byte, []int) {
-	return file_api_ocp_team_api_ocp_team_api_proto_rawDescGZIP(), []int{6}
+	return file_api_ocp_team_api_ocp_team_api_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ListTeamsV1Request) GetLimit() uint64 {
@@ -425,7 +626,7 @@ type ListTeamsV1Response struct {
 func (x *ListTeamsV1Response) Reset() {
 	*x = ListTeamsV1Response{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[7]
+		mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -438,7 +639,7 @@ func (x *ListTeamsV1Response) String() string {
 func (*ListTeamsV1Response) ProtoMessage() {}
 
 func (x *ListTeamsV1Response) ProtoReflect() protoreflect.Message {
-	mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[7]
+	mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -451,7 +652,7 @@ func (x *ListTeamsV1Response) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListTeamsV1Response.ProtoReflect.Descriptor instead.
 func (*ListTeamsV1Response) Descriptor() ([]byte, []int) {
-	return file_api_ocp_team_api_ocp_team_api_proto_rawDescGZIP(), []int{7}
+	return file_api_ocp_team_api_ocp_team_api_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ListTeamsV1Response) GetTotal() uint64 {
@@ -479,7 +680,7 @@ type RemoveTeamV1Request struct {
 func (x *RemoveTeamV1Request) Reset() {
 	*x = RemoveTeamV1Request{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[8]
+		mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -492,7 +693,7 @@ func (x *RemoveTeamV1Request) String() string {
 func (*RemoveTeamV1Request) ProtoMessage() {}
 
 func (x *RemoveTeamV1Request) ProtoReflect() protoreflect.Message {
-	mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[8]
+	mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -505,7 +706,7 @@ func (x *RemoveTeamV1Request) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveTeamV1Request.ProtoReflect.Descriptor instead.
 func (*RemoveTeamV1Request) Descriptor() ([]byte, []int) {
-	return file_api_ocp_team_api_ocp_team_api_proto_rawDescGZIP(), []int{8}
+	return file_api_ocp_team_api_ocp_team_api_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *RemoveTeamV1Request) GetId() uint64 {
@@ -524,7 +725,7 @@ type RemoveTeamV1Response struct {
 func (x *RemoveTeamV1Response) Reset() {
 	*x = RemoveTeamV1Response{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[9]
+		mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -537,7 +738,7 @@ func (x *RemoveTeamV1Response) String() string {
 func (*RemoveTeamV1Response) ProtoMessage() {}
 
 func (x *RemoveTeamV1Response) ProtoReflect() protoreflect.Message {
-	mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[9]
+	mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -550,7 +751,7 @@ func (x *RemoveTeamV1Response) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveTeamV1Response.ProtoReflect.Descriptor instead.
 func (*RemoveTeamV1Response) Descriptor() ([]byte, []int) {
-	return file_api_ocp_team_api_ocp_team_api_proto_rawDescGZIP(), []int{9}
+	return file_api_ocp_team_api_ocp_team_api_proto_rawDescGZIP(), []int{11}
 }
 
 type UpdateTeamV1Request struct {
@@ -564,7 +765,7 @@ type UpdateTeamV1Request struct {
 func (x *UpdateTeamV1Request) Reset() {
 	*x = UpdateTeamV1Request{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[10]
+		mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -577,7 +778,7 @@ func (x *UpdateTeamV1Request) String() string {
 func (*UpdateTeamV1Request) ProtoMessage() {}
 
 func (x *UpdateTeamV1Request) ProtoReflect() protoreflect.Message {
-	mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[10]
+	mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -590,7 +791,7 @@ func (x *UpdateTeamV1Request) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateTeamV1Request.ProtoReflect.Descriptor instead.
 func (*UpdateTeamV1Request) Descriptor() ([]byte, []int) {
-	return file_api_ocp_team_api_ocp_team_api_proto_rawDescGZIP(), []int{10}
+	return file_api_ocp_team_api_ocp_team_api_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *UpdateTeamV1Request) GetTeam() *Team {
@@ -609,7 +810,7 @@ type UpdateTeamV1Response struct {
 func (x *UpdateTeamV1Response) Reset() {
 	*x = UpdateTeamV1Response{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[11]
+		mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -622,7 +823,7 @@ func (x *UpdateTeamV1Response) String() string {
 func (*UpdateTeamV1Response) ProtoMessage() {}
 
 func (x *UpdateTeamV1Response) ProtoReflect() protoreflect.Message {
-	mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[11]
+	mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -635,7 +836,7 @@ func (x *UpdateTeamV1Response) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateTeamV1Response.ProtoReflect.Descriptor instead.
 func (*UpdateTeamV1Response) Descriptor() ([]byte, []int) {
-	return file_api_ocp_team_api_ocp_team_api_proto_rawDescGZIP(), []int{11}
+	return file_api_ocp_team_api_ocp_team_api_proto_rawDescGZIP(), []int{13}
 }
 
 type SearchTeamV1Request struct {
@@ -645,12 +846,19 @@ type SearchTeamV1Request struct {
 
 	Type  SearchTeamV1Request_Type `protobuf:"varint,1,opt,name=type,proto3,enum=ocp.team.api.SearchTeamV1Request_Type" json:"type,omitempty"`
 	Query string                   `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	// Limit is the size of the page. Zero means 20 when page_token or min_score
+	// is set and all the hits otherwise, as before paging was added.
+	Limit uint64 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Page token is next_page_token of the previous page, empty for the first page.
+	PageToken string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Hits with the score below min_score are skipped.
+	MinScore float32 `protobuf:"fixed32,5,opt,name=min_score,json=minScore,proto3" json:"min_score,omitempty"`
 }
 
 func (x *SearchTeamV1Request) Reset() {
 	*x = SearchTeamV1Request{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[12]
+		mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -663,7 +871,7 @@ func (x *SearchTeamV1Request) String() string {
 func (*SearchTeamV1Request) ProtoMessage() {}
 
 func (x *SearchTeamV1Request) ProtoReflect() protoreflect.Message {
-	mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[12]
+	mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -676,7 +884,7 @@ func (x *SearchTeamV1Request) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchTeamV1Request.ProtoReflect.Descriptor instead.
 func (*SearchTeamV1Request) Descriptor() ([]byte, []int) {
-	return file_api_ocp_team_api_ocp_team_api_proto_rawDescGZIP(), []int{12}
+	return file_api_ocp_team_api_ocp_team_api_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *SearchTeamV1Request) GetType() SearchTeamV1Request_Type {
@@ -693,18 +901,45 @@ func (x *SearchTeamV1Request) GetQuery() string {
 	return ""
 }
 
+func (x *SearchTeamV1Request) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SearchTeamV1Request) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *SearchTeamV1Request) GetMinScore() float32 {
+	if x != nil {
+		return x.MinScore
+	}
+	return 0
+}
+
 type SearchTeamV1Response struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Teams []*Team `protobuf:"bytes,2,rep,name=teams,proto3" json:"teams,omitempty"`
+	// Teams are the teams of hits for the clients which do not need scores.
+	Teams []*Team                     `protobuf:"bytes,2,rep,name=teams,proto3" json:"teams,omitempty"`
+	Hits  []*SearchTeamV1Response_Hit `protobuf:"bytes,3,rep,name=hits,proto3" json:"hits,omitempty"`
+	// Total is the number of hits of all pages.
+	Total uint64 `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	// Next page token is empty on the last page.
+	NextPageToken string `protobuf:"bytes,5,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 }
 
 func (x *SearchTeamV1Response) Reset() {
 	*x = SearchTeamV1Response{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[13]
+		mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -717,7 +952,7 @@ func (x *SearchTeamV1Response) String() string {
 func (*SearchTeamV1Response) ProtoMessage() {}
 
 func (x *SearchTeamV1Response) ProtoReflect() protoreflect.Message {
-	mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[13]
+	mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -730,7 +965,7 @@ func (x *SearchTeamV1Response) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchTeamV1Response.ProtoReflect.Descriptor instead.
 func (*SearchTeamV1Response) Descriptor() ([]byte, []int) {
-	return file_api_ocp_team_api_ocp_team_api_proto_rawDescGZIP(), []int{13}
+	return file_api_ocp_team_api_ocp_team_api_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *SearchTeamV1Response) GetTeams() []*Team {
@@ -740,33 +975,54 @@ func (x *SearchTeamV1Response) GetTeams() []*Team {
 	return nil
 }
 
-type Team struct {
+func (x *SearchTeamV1Response) GetHits() []*SearchTeamV1Response_Hit {
+	if x != nil {
+		return x.Hits
+	}
+	return nil
+}
+
+func (x *SearchTeamV1Response) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SearchTeamV1Response) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type SuggestTeamsV1Request struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id          uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name        string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Prefix string `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// Limit is the number of names, zero means 5.
+	Limit uint64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-func (x *Team) Reset() {
-	*x = Team{}
+func (x *SuggestTeamsV1Request) Reset() {
+	*x = SuggestTeamsV1Request{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[14]
+		mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Team) String() string {
+func (x *SuggestTeamsV1Request) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Team) ProtoMessage() {}
+func (*SuggestTeamsV1Request) ProtoMessage() {}
 
-func (x *Team) ProtoReflect() protoreflect.Message {
-	mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[14]
+func (x *SuggestTeamsV1Request) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -777,33 +1033,1660 @@ func (x *Team) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Team.ProtoReflect.Descriptor instead.
-func (*Team) Descriptor() ([]byte, []int) {
-	return file_api_ocp_team_api_ocp_team_api_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use SuggestTeamsV1Request.ProtoReflect.Descriptor instead.
+func (*SuggestTeamsV1Request) Descriptor() ([]byte, []int) {
+	return file_api_ocp_team_api_ocp_team_api_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *Team) GetId() uint64 {
+func (x *SuggestTeamsV1Request) GetPrefix() string {
 	if x != nil {
-		return x.Id
+		return x.Prefix
 	}
-	return 0
+	return ""
 }
 
-func (x *Team) GetName() string {
+func (x *SuggestTeamsV1Request) GetLimit() uint64 {
 	if x != nil {
-		return x.Name
+		return x.Limit
 	}
-	return ""
+	return 0
 }
 
-func (x *Team) GetDescription() string {
-	if x != nil {
-		return x.Description
+type SuggestTeamsV1Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Names are distinct names of teams starting with the prefix, the shortest first.
+	Names []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+}
+
+func (x *SuggestTeamsV1Response) Reset() {
+	*x = SuggestTeamsV1Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-var File_api_ocp_team_api_ocp_team_api_proto protoreflect.FileDescriptor
+func (x *SuggestTeamsV1Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestTeamsV1Response) ProtoMessage() {}
+
+func (x *SuggestTeamsV1Response) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestTeamsV1Response.ProtoReflect.Descriptor instead.
+func (*SuggestTeamsV1Response) Descriptor() ([]byte, []int) {
+	return file_api_ocp_team_api_ocp_team_api_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SuggestTeamsV1Response) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+// OperationMetadataV1 is the metadata of google.longrunning.Operation of asynchronous creation.
+// Operations are named "operations/{id}". Response of the done operation holds MultiCreateTeamV1Response
+// with created ids in the request order, error details of the failed one hold MultiCreateTeamV1Response
+// with ids of teams created before the failure.
+type OperationMetadataV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	State      OperationMetadataV1_State `protobuf:"varint,1,opt,name=state,proto3,enum=ocp.team.api.OperationMetadataV1_State" json:"state,omitempty"`
+	Total      uint32                    `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Created    uint32                    `protobuf:"varint,3,opt,name=created,proto3" json:"created,omitempty"`
+	Failed     uint32                    `protobuf:"varint,4,opt,name=failed,proto3" json:"failed,omitempty"`
+	CreateTime int64                     `protobuf:"varint,5,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	UpdateTime int64                     `protobuf:"varint,6,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+}
+
+func (x *OperationMetadataV1) Reset() {
+	*x = OperationMetadataV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OperationMetadataV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperationMetadataV1) ProtoMessage() {}
+
+func (x *OperationMetadataV1) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperationMetadataV1.ProtoReflect.Descriptor instead.
+func (*OperationMetadataV1) Descriptor() ([]byte, []int) {
+	return file_api_ocp_team_api_ocp_team_api_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *OperationMetadataV1) GetState() OperationMetadataV1_State {
+	if x != nil {
+		return x.State
+	}
+	return OperationMetadataV1_PENDING
+}
+
+func (x *OperationMetadataV1) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *OperationMetadataV1) GetCreated() uint32 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *OperationMetadataV1) GetFailed() uint32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *OperationMetadataV1) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+func (x *OperationMetadataV1) GetUpdateTime() int64 {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return 0
+}
+
+type GetOperationV1Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *GetOperationV1Request) Reset() {
+	*x = GetOperationV1Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetOperationV1Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOperationV1Request) ProtoMessage() {}
+
+func (x *GetOperationV1Request) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOperationV1Request.ProtoReflect.Descriptor instead.
+func (*GetOperationV1Request) Descriptor() ([]byte, []int) {
+	return file_api_ocp_team_api_ocp_team_api_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetOperationV1Request) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ListOperationsV1Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Filter is either empty, "done=true" or "done=false".
+	Filter    string `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	PageSize  int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *ListOperationsV1Request) Reset() {
+	*x = ListOperationsV1Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListOperationsV1Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOperationsV1Request) ProtoMessage() {}
+
+func (x *ListOperationsV1Request) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOperationsV1Request.ProtoReflect.Descriptor instead.
+func (*ListOperationsV1Request) Descriptor() ([]byte, []int) {
+	return file_api_ocp_team_api_ocp_team_api_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListOperationsV1Request) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *ListOperationsV1Request) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListOperationsV1Request) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListOperationsV1Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Operations    []*longrunning.Operation `protobuf:"bytes,1,rep,name=operations,proto3" json:"operations,omitempty"`
+	NextPageToken string                   `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListOperationsV1Response) Reset() {
+	*x = ListOperationsV1Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListOperationsV1Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOperationsV1Response) ProtoMessage() {}
+
+func (x *ListOperationsV1Response) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOperationsV1Response.ProtoReflect.Descriptor instead.
+func (*ListOperationsV1Response) Descriptor() ([]byte, []int) {
+	return file_api_ocp_team_api_ocp_team_api_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListOperationsV1Response) GetOperations() []*longrunning.Operation {
+	if x != nil {
+		return x.Operations
+	}
+	return nil
+}
+
+func (x *ListOperationsV1Response) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type CreateWebhookV1Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Url must be absolute http or https URL.
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// Events the subscription is interested in; empty list means all events.
+	Events []string `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
+	// Secret is used for HMAC-SHA256 signature of the delivered payload.
+	Secret string `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`
+}
+
+func (x *CreateWebhookV1Request) Reset() {
+	*x = CreateWebhookV1Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateWebhookV1Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookV1Request) ProtoMessage() {}
+
+func (x *CreateWebhookV1Request) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookV1Request.ProtoReflect.Descriptor instead.
+func (*CreateWebhookV1Request) Descriptor() ([]byte, []int) {
+	return file_api_ocp_team_api_ocp_team_api_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CreateWebhookV1Request) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CreateWebhookV1Request) GetEvents() []string {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *CreateWebhookV1Request) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+type CreateWebhookV1Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *CreateWebhookV1Response) Reset() {
+	*x = CreateWebhookV1Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateWebhookV1Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookV1Response) ProtoMessage() {}
+
+func (x *CreateWebhookV1Response) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookV1Response.ProtoReflect.Descriptor instead.
+func (*CreateWebhookV1Response) Descriptor() ([]byte, []int) {
+	return file_api_ocp_team_api_ocp_team_api_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CreateWebhookV1Response) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ListWebhooksV1Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListWebhooksV1Request) Reset() {
+	*x = ListWebhooksV1Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWebhooksV1Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhooksV1Request) ProtoMessage() {}
+
+func (x *ListWebhooksV1Request) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhooksV1Request.ProtoReflect.Descriptor instead.
+func (*ListWebhooksV1Request) Descriptor() ([]byte, []int) {
+	return file_api_ocp_team_api_ocp_team_api_proto_rawDescGZIP(), []int{24}
+}
+
+type ListWebhooksV1Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Webhooks []*Webhook `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
+}
+
+func (x *ListWebhooksV1Response) Reset() {
+	*x = ListWebhooksV1Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWebhooksV1Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhooksV1Response) ProtoMessage() {}
+
+func (x *ListWebhooksV1Response) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhooksV1Response.ProtoReflect.Descriptor instead.
+func (*ListWebhooksV1Response) Descriptor() ([]byte, []int) {
+	return file_api_ocp_team_api_ocp_team_api_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListWebhooksV1Response) GetWebhooks() []*Webhook {
+	if x != nil {
+		return x.Webhooks
+	}
+	return nil
+}
+
+type RemoveWebhookV1Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RemoveWebhookV1Request) Reset() {
+	*x = RemoveWebhookV1Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveWebhookV1Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveWebhookV1Request) ProtoMessage() {}
+
+func (x *RemoveWebhookV1Request) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveWebhookV1Request.ProtoReflect.Descriptor instead.
+func (*RemoveWebhookV1Request) Descriptor() ([]byte, []int) {
+	return file_api_ocp_team_api_ocp_team_api_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *RemoveWebhookV1Request) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RemoveWebhookV1Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RemoveWebhookV1Response) Reset() {
+	*x = RemoveWebhookV1Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveWebhookV1Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveWebhookV1Response) ProtoMessage() {}
+
+func (x *RemoveWebhookV1Response) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveWebhookV1Response.ProtoReflect.Descriptor instead.
+func (*RemoveWebhookV1Response) Descriptor() ([]byte, []int) {
+	return file_api_ocp_team_api_ocp_team_api_proto_rawDescGZIP(), []int{27}
+}
+
+type ListWebhookDeliveriesV1Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WebhookId uint64 `protobuf:"varint,1,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+	Limit     uint64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *ListWebhookDeliveriesV1Request) Reset() {
+	*x = ListWebhookDeliveriesV1Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWebhookDeliveriesV1Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeliveriesV1Request) ProtoMessage() {}
+
+func (x *ListWebhookDeliveriesV1Request) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeliveriesV1Request.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesV1Request) Descriptor() ([]byte, []int) {
+	return file_api_ocp_team_api_ocp_team_api_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListWebhookDeliveriesV1Request) GetWebhookId() uint64 {
+	if x != nil {
+		return x.WebhookId
+	}
+	return 0
+}
+
+func (x *ListWebhookDeliveriesV1Request) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListWebhookDeliveriesV1Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Deliveries []*WebhookDelivery `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+}
+
+func (x *ListWebhookDeliveriesV1Response) Reset() {
+	*x = ListWebhookDeliveriesV1Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWebhookDeliveriesV1Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeliveriesV1Response) ProtoMessage() {}
+
+func (x *ListWebhookDeliveriesV1Response) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeliveriesV1Response.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesV1Response) Descriptor() ([]byte, []int) {
+	return file_api_ocp_team_api_ocp_team_api_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListWebhookDeliveriesV1Response) GetDeliveries() []*WebhookDelivery {
+	if x != nil {
+		return x.Deliveries
+	}
+	return nil
+}
+
+type Webhook struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Url      string   `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Events   []string `protobuf:"bytes,3,rep,name=events,proto3" json:"events,omitempty"`
+	Enabled  bool     `protobuf:"varint,4,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Failures uint32   `protobuf:"varint,5,opt,name=failures,proto3" json:"failures,omitempty"`
+}
+
+func (x *Webhook) Reset() {
+	*x = Webhook{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Webhook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Webhook) ProtoMessage() {}
+
+func (x *Webhook) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Webhook.ProtoReflect.Descriptor instead.
+func (*Webhook) Descriptor() ([]byte, []int) {
+	return file_api_ocp_team_api_ocp_team_api_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *Webhook) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Webhook) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Webhook) GetEvents() []string {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *Webhook) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Webhook) GetFailures() uint32 {
+	if x != nil {
+		return x.Failures
+	}
+	return 0
+}
+
+type WebhookDelivery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	WebhookId   uint64 `protobuf:"varint,2,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+	Event       string `protobuf:"bytes,3,opt,name=event,proto3" json:"event,omitempty"`
+	TeamId      uint64 `protobuf:"varint,4,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	Success     bool   `protobuf:"varint,5,opt,name=success,proto3" json:"success,omitempty"`
+	Attempts    uint32 `protobuf:"varint,6,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	StatusCode  int32  `protobuf:"varint,7,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	Error       string `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	DeliveredAt int64  `protobuf:"varint,9,opt,name=delivered_at,json=deliveredAt,proto3" json:"delivered_at,omitempty"`
+}
+
+func (x *WebhookDelivery) Reset() {
+	*x = WebhookDelivery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WebhookDelivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDelivery) ProtoMessage() {}
+
+func (x *WebhookDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDelivery.ProtoReflect.Descriptor instead.
+func (*WebhookDelivery) Descriptor() ([]byte, []int) {
+	return file_api_ocp_team_api_ocp_team_api_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *WebhookDelivery) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetWebhookId() uint64 {
+	if x != nil {
+		return x.WebhookId
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetTeamId() uint64 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *WebhookDelivery) GetAttempts() uint32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetDeliveredAt() int64 {
+	if x != nil {
+		return x.DeliveredAt
+	}
+	return 0
+}
+
+type ListDeadLettersV1Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Limit  uint64 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset uint64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+}
+
+func (x *ListDeadLettersV1Request) Reset() {
+	*x = ListDeadLettersV1Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDeadLettersV1Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeadLettersV1Request) ProtoMessage() {}
+
+func (x *ListDeadLettersV1Request) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeadLettersV1Request.ProtoReflect.Descriptor instead.
+func (*ListDeadLettersV1Request) Descriptor() ([]byte, []int) {
+	return file_api_ocp_team_api_ocp_team_api_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListDeadLettersV1Request) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListDeadLettersV1Request) GetOffset() uint64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ListDeadLettersV1Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total       uint64        `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	DeadLetters []*DeadLetter `protobuf:"bytes,2,rep,name=dead_letters,json=deadLetters,proto3" json:"dead_letters,omitempty"`
+}
+
+func (x *ListDeadLettersV1Response) Reset() {
+	*x = ListDeadLettersV1Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDeadLettersV1Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeadLettersV1Response) ProtoMessage() {}
+
+func (x *ListDeadLettersV1Response) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeadLettersV1Response.ProtoReflect.Descriptor instead.
+func (*ListDeadLettersV1Response) Descriptor() ([]byte, []int) {
+	return file_api_ocp_team_api_ocp_team_api_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListDeadLettersV1Response) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListDeadLettersV1Response) GetDeadLetters() []*DeadLetter {
+	if x != nil {
+		return x.DeadLetters
+	}
+	return nil
+}
+
+type GetDeadLetterV1Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetDeadLetterV1Request) Reset() {
+	*x = GetDeadLetterV1Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDeadLetterV1Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeadLetterV1Request) ProtoMessage() {}
+
+func (x *GetDeadLetterV1Request) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeadLetterV1Request.ProtoReflect.Descriptor instead.
+func (*GetDeadLetterV1Request) Descriptor() ([]byte, []int) {
+	return file_api_ocp_team_api_ocp_team_api_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetDeadLetterV1Request) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetDeadLetterV1Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeadLetter *DeadLetter `protobuf:"bytes,1,opt,name=dead_letter,json=deadLetter,proto3" json:"dead_letter,omitempty"`
+}
+
+func (x *GetDeadLetterV1Response) Reset() {
+	*x = GetDeadLetterV1Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDeadLetterV1Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeadLetterV1Response) ProtoMessage() {}
+
+func (x *GetDeadLetterV1Response) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeadLetterV1Response.ProtoReflect.Descriptor instead.
+func (*GetDeadLetterV1Response) Descriptor() ([]byte, []int) {
+	return file_api_ocp_team_api_ocp_team_api_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetDeadLetterV1Response) GetDeadLetter() *DeadLetter {
+	if x != nil {
+		return x.DeadLetter
+	}
+	return nil
+}
+
+// RetryDeadLetterV1Request creates the team of the dead letter.
+// Non-empty name and description replace the stored ones before the retry.
+// The team already created is only written to the sinks it is not written to,
+// so it can not be edited.
+type RetryDeadLetterV1Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (x *RetryDeadLetterV1Request) Reset() {
+	*x = RetryDeadLetterV1Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RetryDeadLetterV1Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryDeadLetterV1Request) ProtoMessage() {}
+
+func (x *RetryDeadLetterV1Request) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryDeadLetterV1Request.ProtoReflect.Descriptor instead.
+func (*RetryDeadLetterV1Request) Descriptor() ([]byte, []int) {
+	return file_api_ocp_team_api_ocp_team_api_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *RetryDeadLetterV1Request) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RetryDeadLetterV1Request) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RetryDeadLetterV1Request) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type RetryDeadLetterV1Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TeamId uint64 `protobuf:"varint,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+}
+
+func (x *RetryDeadLetterV1Response) Reset() {
+	*x = RetryDeadLetterV1Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RetryDeadLetterV1Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryDeadLetterV1Response) ProtoMessage() {}
+
+func (x *RetryDeadLetterV1Response) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryDeadLetterV1Response.ProtoReflect.Descriptor instead.
+func (*RetryDeadLetterV1Response) Descriptor() ([]byte, []int) {
+	return file_api_ocp_team_api_ocp_team_api_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *RetryDeadLetterV1Response) GetTeamId() uint64 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+type RemoveDeadLetterV1Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RemoveDeadLetterV1Request) Reset() {
+	*x = RemoveDeadLetterV1Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveDeadLetterV1Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveDeadLetterV1Request) ProtoMessage() {}
+
+func (x *RemoveDeadLetterV1Request) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveDeadLetterV1Request.ProtoReflect.Descriptor instead.
+func (*RemoveDeadLetterV1Request) Descriptor() ([]byte, []int) {
+	return file_api_ocp_team_api_ocp_team_api_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *RemoveDeadLetterV1Request) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RemoveDeadLetterV1Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RemoveDeadLetterV1Response) Reset() {
+	*x = RemoveDeadLetterV1Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveDeadLetterV1Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveDeadLetterV1Response) ProtoMessage() {}
+
+func (x *RemoveDeadLetterV1Response) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveDeadLetterV1Response.ProtoReflect.Descriptor instead.
+func (*RemoveDeadLetterV1Response) Descriptor() ([]byte, []int) {
+	return file_api_ocp_team_api_ocp_team_api_proto_rawDescGZIP(), []int{39}
+}
+
+type DeadLetter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Team has id when it is created but not written to the sinks.
+	Team       *Team  `protobuf:"bytes,2,opt,name=team,proto3" json:"team,omitempty"`
+	Error      string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	Attempts   uint32 `protobuf:"varint,4,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	CreateTime int64  `protobuf:"varint,5,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	UpdateTime int64  `protobuf:"varint,6,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	// Sinks are the names of the sinks the created team is not written to,
+	// empty sinks of such team mean all the sinks.
+	Sinks []string `protobuf:"bytes,7,rep,name=sinks,proto3" json:"sinks,omitempty"`
+}
+
+func (x *DeadLetter) Reset() {
+	*x = DeadLetter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeadLetter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeadLetter) ProtoMessage() {}
+
+func (x *DeadLetter) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeadLetter.ProtoReflect.Descriptor instead.
+func (*DeadLetter) Descriptor() ([]byte, []int) {
+	return file_api_ocp_team_api_ocp_team_api_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *DeadLetter) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeadLetter) GetTeam() *Team {
+	if x != nil {
+		return x.Team
+	}
+	return nil
+}
+
+func (x *DeadLetter) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetAttempts() uint32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *DeadLetter) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+func (x *DeadLetter) GetUpdateTime() int64 {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return 0
+}
+
+func (x *DeadLetter) GetSinks() []string {
+	if x != nil {
+		return x.Sinks
+	}
+	return nil
+}
+
+type Team struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	ExternalId  string `protobuf:"bytes,4,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+}
+
+func (x *Team) Reset() {
+	*x = Team{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Team) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Team) ProtoMessage() {}
+
+func (x *Team) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Team.ProtoReflect.Descriptor instead.
+func (*Team) Descriptor() ([]byte, []int) {
+	return file_api_ocp_team_api_ocp_team_api_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *Team) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Team) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Team) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Team) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
+type UpsertTeamsV1Request_Team struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExternalId  string `protobuf:"bytes,1,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	Name        string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (x *UpsertTeamsV1Request_Team) Reset() {
+	*x = UpsertTeamsV1Request_Team{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpsertTeamsV1Request_Team) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertTeamsV1Request_Team) ProtoMessage() {}
+
+func (x *UpsertTeamsV1Request_Team) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertTeamsV1Request_Team.ProtoReflect.Descriptor instead.
+func (*UpsertTeamsV1Request_Team) Descriptor() ([]byte, []int) {
+	return file_api_ocp_team_api_ocp_team_api_proto_rawDescGZIP(), []int{4, 0}
+}
+
+func (x *UpsertTeamsV1Request_Team) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
+func (x *UpsertTeamsV1Request_Team) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpsertTeamsV1Request_Team) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type UpsertTeamsV1Response_Result struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         uint64                       `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ExternalId string                       `protobuf:"bytes,2,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	Status     UpsertTeamsV1Response_Status `protobuf:"varint,3,opt,name=status,proto3,enum=ocp.team.api.UpsertTeamsV1Response_Status" json:"status,omitempty"`
+}
+
+func (x *UpsertTeamsV1Response_Result) Reset() {
+	*x = UpsertTeamsV1Response_Result{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpsertTeamsV1Response_Result) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertTeamsV1Response_Result) ProtoMessage() {}
+
+func (x *UpsertTeamsV1Response_Result) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertTeamsV1Response_Result.ProtoReflect.Descriptor instead.
+func (*UpsertTeamsV1Response_Result) Descriptor() ([]byte, []int) {
+	return file_api_ocp_team_api_ocp_team_api_proto_rawDescGZIP(), []int{5, 0}
+}
+
+func (x *UpsertTeamsV1Response_Result) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpsertTeamsV1Response_Result) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
+func (x *UpsertTeamsV1Response_Result) GetStatus() UpsertTeamsV1Response_Status {
+	if x != nil {
+		return x.Status
+	}
+	return UpsertTeamsV1Response_UNCHANGED
+}
+
+type SearchTeamV1Response_Hit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Team *Team `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	// Score is the rank of the team, hits are ordered by it from the highest.
+	Score float32 `protobuf:"fixed32,2,opt,name=score,proto3" json:"score,omitempty"`
+}
+
+func (x *SearchTeamV1Response_Hit) Reset() {
+	*x = SearchTeamV1Response_Hit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchTeamV1Response_Hit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchTeamV1Response_Hit) ProtoMessage() {}
+
+func (x *SearchTeamV1Response_Hit) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_team_api_ocp_team_api_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchTeamV1Response_Hit.ProtoReflect.Descriptor instead.
+func (*SearchTeamV1Response_Hit) Descriptor() ([]byte, []int) {
+	return file_api_ocp_team_api_ocp_team_api_proto_rawDescGZIP(), []int{15, 0}
+}
+
+func (x *SearchTeamV1Response_Hit) GetTeam() *Team {
+	if x != nil {
+		return x.Team
+	}
+	return nil
+}
+
+func (x *SearchTeamV1Response_Hit) GetScore() float32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+var File_api_ocp_team_api_ocp_team_api_proto protoreflect.FileDescriptor
 
 var file_api_ocp_team_api_ocp_team_api_proto_rawDesc = []byte{
 	0x0a, 0x23, 0x61, 0x70, 0x69, 0x2f, 0x6f, 0x63, 0x70, 0x2d, 0x74, 0x65, 0x61, 0x6d, 0x2d, 0x61,
@@ -811,130 +2694,459 @@ var file_api_ocp_team_api_ocp_team_api_proto_rawDesc = []byte{
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x6f, 0x63, 0x70, 0x2e, 0x74, 0x65, 0x61, 0x6d, 0x2e,
 	0x61, 0x70, 0x69, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f,
 	0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x1a, 0x41, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x65, 0x6e,
-	0x76, 0x6f, 0x79, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d,
-	0x67, 0x65, 0x6e, 0x2d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x60, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65,
-	0x61, 0x6d, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xfa, 0x42, 0x06, 0x72, 0x04,
-	0x10, 0x03, 0x18, 0x64, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x0b, 0x64, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0x90, 0x4e, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x26, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x54, 0x65, 0x61, 0x6d, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x5d,
-	0x0a, 0x18, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x61,
-	0x6d, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x41, 0x0a, 0x05, 0x74, 0x65,
-	0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6f, 0x63, 0x70, 0x2e,
-	0x74, 0x65, 0x61, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54,
-	0x65, 0x61, 0x6d, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x08, 0xfa, 0x42,
-	0x05, 0x92, 0x01, 0x02, 0x08, 0x02, 0x52, 0x05, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x22, 0x2d, 0x0a,
-	0x19, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x61, 0x6d,
-	0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0x2b, 0x0a, 0x10,
-	0x47, 0x65, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42,
-	0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x02, 0x69, 0x64, 0x22, 0x3b, 0x0a, 0x11, 0x47, 0x65, 0x74,
-	0x54, 0x65, 0x61, 0x6d, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26,
-	0x0a, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6f,
-	0x63, 0x70, 0x2e, 0x74, 0x65, 0x61, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x65, 0x61, 0x6d,
-	0x52, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x22, 0x4d, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x65,
-	0x61, 0x6d, 0x73, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x05,
-	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x09, 0xfa, 0x42, 0x06,
-	0x32, 0x04, 0x18, 0x64, 0x20, 0x00, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a,
-	0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6f,
-	0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x55, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x65, 0x61,
-	0x6d, 0x73, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05,
-	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x74, 0x6f, 0x74,
-	0x61, 0x6c, 0x12, 0x28, 0x0a, 0x05, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x12, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x74, 0x65, 0x61, 0x6d, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x05, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x22, 0x2e, 0x0a, 0x13,
-	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42,
-	0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x02, 0x69, 0x64, 0x22, 0x16, 0x0a, 0x14,
-	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3d, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65,
-	0x61, 0x6d, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x04, 0x74,
-	0x65, 0x61, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6f, 0x63, 0x70, 0x2e,
-	0x74, 0x65, 0x61, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x04, 0x74,
-	0x65, 0x61, 0x6d, 0x22, 0x16, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x61,
-	0x6d, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x90, 0x01, 0x0a, 0x13,
-	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x54, 0x65, 0x61, 0x6d, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x44, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x26, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x74, 0x65, 0x61, 0x6d, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x54, 0x65, 0x61, 0x6d, 0x56, 0x31, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x82, 0x01,
-	0x02, 0x10, 0x01, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65,
-	0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x22,
-	0x1d, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x50, 0x4c, 0x41, 0x49, 0x4e,
-	0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x50, 0x48, 0x52, 0x41, 0x53, 0x45, 0x10, 0x01, 0x22, 0x40,
-	0x0a, 0x14, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x54, 0x65, 0x61, 0x6d, 0x56, 0x31, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x74, 0x65, 0x61, 0x6d,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x05, 0x74, 0x65, 0x61, 0x6d, 0x73,
-	0x22, 0x6a, 0x0a, 0x04, 0x54, 0x65, 0x61, 0x6d, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x1d, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x09, 0xfa, 0x42, 0x06, 0x72, 0x04, 0x10, 0x03, 0x18, 0x64, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x2a, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0x90, 0x4e, 0x52,
-	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x32, 0x9f, 0x06, 0x0a,
-	0x0a, 0x4f, 0x63, 0x70, 0x54, 0x65, 0x61, 0x6d, 0x41, 0x70, 0x69, 0x12, 0x6b, 0x0a, 0x0c, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x56, 0x31, 0x12, 0x21, 0x2e, 0x6f, 0x63,
-	0x70, 0x2e, 0x74, 0x65, 0x61, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x54, 0x65, 0x61, 0x6d, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22,
+	0x6f, 0x1a, 0x23, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x6c, 0x6f, 0x6e, 0x67, 0x72, 0x75,
+	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x41, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x60, 0x0a, 0x13, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1d, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09,
+	0xfa, 0x42, 0x06, 0x72, 0x04, 0x10, 0x03, 0x18, 0x64, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x2a, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0x90, 0x4e, 0x52, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x26, 0x0a, 0x14, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0x5d, 0x0a, 0x18, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x41, 0x0a, 0x05, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21,
 	0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x74, 0x65, 0x61, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x14, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x22, 0x09, 0x2f, 0x76, 0x31, 0x2f,
-	0x74, 0x65, 0x61, 0x6d, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x85, 0x01, 0x0a, 0x11, 0x4d, 0x75, 0x6c,
-	0x74, 0x69, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x56, 0x31, 0x12, 0x26,
-	0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x74, 0x65, 0x61, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x75,
-	0x6c, 0x74, 0x69, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x56, 0x31, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x74, 0x65, 0x61,
-	0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x54, 0x65, 0x61, 0x6d, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x22, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x65, 0x61,
-	0x6d, 0x73, 0x2f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x01, 0x2a,
-	0x12, 0x64, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x56, 0x31, 0x12, 0x1e, 0x2e,
-	0x6f, 0x63, 0x70, 0x2e, 0x74, 0x65, 0x61, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74,
-	0x54, 0x65, 0x61, 0x6d, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e,
-	0x6f, 0x63, 0x70, 0x2e, 0x74, 0x65, 0x61, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74,
-	0x54, 0x65, 0x61, 0x6d, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x16,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x12, 0x0e, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x65, 0x61, 0x6d,
-	0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x65, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x65,
-	0x61, 0x6d, 0x73, 0x56, 0x31, 0x12, 0x20, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x74, 0x65, 0x61, 0x6d,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x73, 0x56, 0x31,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x74, 0x65,
-	0x61, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x73,
-	0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x11, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x0b, 0x12, 0x09, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x12, 0x6d, 0x0a,
-	0x0c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x56, 0x31, 0x12, 0x21, 0x2e,
-	0x6f, 0x63, 0x70, 0x2e, 0x74, 0x65, 0x61, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x6d,
-	0x6f, 0x76, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x22, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x74, 0x65, 0x61, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x2a, 0x0e, 0x2f, 0x76,
-	0x31, 0x2f, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x6b, 0x0a, 0x0c,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x56, 0x31, 0x12, 0x21, 0x2e, 0x6f,
-	0x63, 0x70, 0x2e, 0x74, 0x65, 0x61, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x22, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x74, 0x65, 0x61, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x14, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x1a, 0x09, 0x2f, 0x76, 0x31,
-	0x2f, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x73, 0x0a, 0x0d, 0x53, 0x65, 0x61,
-	0x72, 0x63, 0x68, 0x54, 0x65, 0x61, 0x6d, 0x73, 0x56, 0x31, 0x12, 0x21, 0x2e, 0x6f, 0x63, 0x70,
+	0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x02, 0x52, 0x05, 0x74, 0x65, 0x61,
+	0x6d, 0x73, 0x22, 0x2d, 0x0a, 0x19, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x54, 0x65, 0x61, 0x6d, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x03, 0x69, 0x64,
+	0x73, 0x22, 0xdf, 0x01, 0x0a, 0x14, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x54, 0x65, 0x61, 0x6d,
+	0x73, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x47, 0x0a, 0x05, 0x74, 0x65,
+	0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6f, 0x63, 0x70, 0x2e,
+	0x74, 0x65, 0x61, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x54,
+	0x65, 0x61, 0x6d, 0x73, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x54, 0x65,
+	0x61, 0x6d, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x52, 0x05, 0x74, 0x65,
+	0x61, 0x6d, 0x73, 0x1a, 0x7e, 0x0a, 0x04, 0x54, 0x65, 0x61, 0x6d, 0x12, 0x2b, 0x0a, 0x0b, 0x65,
+	0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x0a, 0xfa, 0x42, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0xff, 0x01, 0x52, 0x0a, 0x65, 0x78,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xfa, 0x42, 0x06, 0x72, 0x04, 0x10, 0x03, 0x18,
+	0x64, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42,
+	0x05, 0x72, 0x03, 0x18, 0x90, 0x4e, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x8f, 0x02, 0x0a, 0x15, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x54, 0x65,
+	0x61, 0x6d, 0x73, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a,
+	0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a,
+	0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x74, 0x65, 0x61, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70,
+	0x73, 0x65, 0x72, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x73, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x1a, 0x7d, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a,
+	0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x42,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2a,
+	0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x74, 0x65, 0x61, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70,
+	0x73, 0x65, 0x72, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x73, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x22, 0x31, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0d, 0x0a, 0x09,
+	0x55, 0x4e, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x43,
+	0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x50, 0x44, 0x41,
+	0x54, 0x45, 0x44, 0x10, 0x02, 0x22, 0x2b, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x54, 0x65, 0x61, 0x6d,
+	0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0x3b, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x56, 0x31, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x74, 0x65, 0x61, 0x6d,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x22,
+	0x4d, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x73, 0x56, 0x31, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x09, 0xfa, 0x42, 0x06, 0x32, 0x04, 0x18, 0x64, 0x20, 0x00, 0x52,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x55,
+	0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x73, 0x56, 0x31, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x28, 0x0a, 0x05, 0x74,
+	0x65, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6f, 0x63, 0x70,
+	0x2e, 0x74, 0x65, 0x61, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x05,
+	0x74, 0x65, 0x61, 0x6d, 0x73, 0x22, 0x2e, 0x0a, 0x13, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54,
+	0x65, 0x61, 0x6d, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20,
+	0x00, 0x52, 0x02, 0x69, 0x64, 0x22, 0x16, 0x0a, 0x14, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54,
+	0x65, 0x61, 0x6d, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3d, 0x0a,
+	0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x56, 0x31, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x74, 0x65, 0x61, 0x6d, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x22, 0x16, 0x0a, 0x14,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x92, 0x02, 0x0a, 0x13, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x54,
+	0x65, 0x61, 0x6d, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x44, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x6f, 0x63, 0x70,
+	0x2e, 0x74, 0x65, 0x61, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x54, 0x65, 0x61, 0x6d, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x54, 0x79,
+	0x70, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x1d, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x18, 0x64,
+	0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x27, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x0a, 0x05,
+	0x2d, 0x00, 0x00, 0x00, 0x00, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x22,
+	0x38, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x50, 0x4c, 0x41, 0x49, 0x4e,
+	0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x50, 0x48, 0x52, 0x41, 0x53, 0x45, 0x10, 0x01, 0x12, 0x0d,
+	0x0a, 0x09, 0x57, 0x45, 0x42, 0x53, 0x45, 0x41, 0x52, 0x43, 0x48, 0x10, 0x02, 0x12, 0x0a, 0x0a,
+	0x06, 0x50, 0x52, 0x45, 0x46, 0x49, 0x58, 0x10, 0x03, 0x22, 0xff, 0x01, 0x0a, 0x14, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x54, 0x65, 0x61, 0x6d, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x74, 0x65, 0x61, 0x6d, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x05, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x12, 0x3a, 0x0a, 0x04,
+	0x68, 0x69, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6f, 0x63, 0x70,
 	0x2e, 0x74, 0x65, 0x61, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
-	0x54, 0x65, 0x61, 0x6d, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e,
-	0x6f, 0x63, 0x70, 0x2e, 0x74, 0x65, 0x61, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x61,
-	0x72, 0x63, 0x68, 0x54, 0x65, 0x61, 0x6d, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x22, 0x10, 0x2f, 0x76, 0x31, 0x2f, 0x74,
-	0x65, 0x61, 0x6d, 0x73, 0x2f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x3a, 0x01, 0x2a, 0x42, 0x3e,
-	0x5a, 0x3c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x7a, 0x6f,
-	0x6e, 0x63, 0x70, 0x2f, 0x6f, 0x63, 0x70, 0x2d, 0x74, 0x65, 0x61, 0x6d, 0x2d, 0x61, 0x70, 0x69,
-	0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x6f, 0x63, 0x70, 0x2d, 0x74, 0x65, 0x61, 0x6d, 0x2d, 0x61, 0x70,
-	0x69, 0x3b, 0x6f, 0x63, 0x70, 0x5f, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x61, 0x70, 0x69, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x54, 0x65, 0x61, 0x6d, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x48,
+	0x69, 0x74, 0x52, 0x04, 0x68, 0x69, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x26,
+	0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x1a, 0x43, 0x0a, 0x03, 0x48, 0x69, 0x74, 0x12, 0x26, 0x0a,
+	0x04, 0x74, 0x65, 0x61, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6f, 0x63,
+	0x70, 0x2e, 0x74, 0x65, 0x61, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x52,
+	0x04, 0x74, 0x65, 0x61, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x59, 0x0a, 0x15, 0x53,
+	0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x73, 0x56, 0x31, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xfa, 0x42, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x64, 0x52,
+	0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x1d, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x18, 0x14, 0x52,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x2e, 0x0a, 0x16, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73,
+	0x74, 0x54, 0x65, 0x61, 0x6d, 0x73, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x8a, 0x02, 0x0a, 0x13, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x56, 0x31, 0x12, 0x3d,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e,
+	0x6f, 0x63, 0x70, 0x2e, 0x74, 0x65, 0x61, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x56, 0x31,
+	0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x66,
+	0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x2a, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x0b, 0x0a, 0x07, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x08, 0x0a,
+	0x04, 0x44, 0x4f, 0x4e, 0x45, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x41, 0x49, 0x4c, 0x45,
+	0x44, 0x10, 0x02, 0x22, 0x46, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x19, 0xfa, 0x42, 0x16, 0x72,
+	0x14, 0x32, 0x12, 0x5e, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x5b,
+	0x5e, 0x2f, 0x5d, 0x2b, 0x24, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x98, 0x01, 0x0a, 0x17,
+	0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x56, 0x31,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xfa, 0x42, 0x1b, 0x72, 0x19, 0x52, 0x00,
+	0x52, 0x09, 0x64, 0x6f, 0x6e, 0x65, 0x3d, 0x74, 0x72, 0x75, 0x65, 0x52, 0x0a, 0x64, 0x6f, 0x6e,
+	0x65, 0x3d, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12,
+	0x26, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x42, 0x09, 0xfa, 0x42, 0x06, 0x1a, 0x04, 0x18, 0x64, 0x28, 0x00, 0x52, 0x08, 0x70,
+	0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x81, 0x01, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x6c, 0x6f, 0x6e, 0x67, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78,
+	0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xaf, 0x01, 0x0a, 0x16, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x56, 0x31, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x17, 0xfa, 0x42, 0x14, 0x72, 0x12, 0x18, 0x80, 0x10, 0x32, 0x0a, 0x5e, 0x68,
+	0x74, 0x74, 0x70, 0x73, 0x3f, 0x3a, 0x2f, 0x2f, 0x88, 0x01, 0x01, 0x52, 0x03, 0x75, 0x72, 0x6c,
+	0x12, 0x46, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x42, 0x2e, 0xfa, 0x42, 0x2b, 0x92, 0x01, 0x28, 0x18, 0x01, 0x22, 0x24, 0x72, 0x22, 0x52, 0x06,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x06, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x06,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x08, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x22, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x72, 0x05, 0x10,
+	0x10, 0x18, 0x80, 0x02, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x29, 0x0a, 0x17,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x56, 0x31, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x17, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x57,
+	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x4b, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73,
+	0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x08, 0x77, 0x65,
+	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6f,
+	0x63, 0x70, 0x2e, 0x74, 0x65, 0x61, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x65, 0x62, 0x68,
+	0x6f, 0x6f, 0x6b, 0x52, 0x08, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x22, 0x31, 0x0a,
+	0x16, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x56, 0x31,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0x19, 0x0a, 0x17, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x69, 0x0a, 0x1e, 0x4c,
+	0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x69, 0x65, 0x73, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a,
+	0x0a, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x09, 0x77, 0x65, 0x62, 0x68,
+	0x6f, 0x6f, 0x6b, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x09, 0xfa, 0x42, 0x06, 0x32, 0x04, 0x18, 0x64, 0x20, 0x00, 0x52,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x60, 0x0a, 0x1f, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65,
+	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x56,
+	0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0a, 0x64, 0x65, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x6f, 0x63, 0x70, 0x2e, 0x74, 0x65, 0x61, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x65, 0x62,
+	0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x0a, 0x64, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x22, 0x79, 0x0a, 0x07, 0x57, 0x65, 0x62, 0x68,
+	0x6f, 0x6f, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x75,
+	0x72, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x75,
+	0x72, 0x65, 0x73, 0x22, 0xff, 0x01, 0x0a, 0x0f, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44,
+	0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x65, 0x62, 0x68, 0x6f,
+	0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x77, 0x65, 0x62,
+	0x68, 0x6f, 0x6f, 0x6b, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x74, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x74,
+	0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
+	0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x65, 0x64, 0x41, 0x74, 0x22, 0x53, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x61,
+	0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1f, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x09, 0xfa, 0x42, 0x06, 0x32, 0x04, 0x18, 0x64, 0x20, 0x00, 0x52, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x6e, 0x0a, 0x19, 0x4c, 0x69,
+	0x73, 0x74, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x56, 0x31, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x3b, 0x0a,
+	0x0c, 0x64, 0x65, 0x61, 0x64, 0x5f, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x74, 0x65, 0x61, 0x6d, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x52, 0x0b, 0x64,
+	0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x22, 0x31, 0x0a, 0x16, 0x47, 0x65,
+	0x74, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x02, 0x69, 0x64, 0x22, 0x54, 0x0a,
+	0x17, 0x47, 0x65, 0x74, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x56, 0x31,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x0b, 0x64, 0x65, 0x61, 0x64,
+	0x5f, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x6f, 0x63, 0x70, 0x2e, 0x74, 0x65, 0x61, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x61,
+	0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x52, 0x0a, 0x64, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74,
+	0x74, 0x65, 0x72, 0x22, 0x81, 0x01, 0x0a, 0x18, 0x52, 0x65, 0x74, 0x72, 0x79, 0x44, 0x65, 0x61,
+	0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42,
+	0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x02, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xfa, 0x42, 0x09, 0x72, 0x07, 0x10, 0x03,
+	0x18, 0x64, 0xd0, 0x01, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0x90, 0x4e, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x34, 0x0a, 0x19, 0x52, 0x65, 0x74, 0x72, 0x79,
+	0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x22, 0x34, 0x0a,
+	0x19, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65,
+	0x72, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0x1c, 0x0a, 0x1a, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x44, 0x65, 0x61,
+	0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0xce, 0x01, 0x0a, 0x0a, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x26, 0x0a, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x74, 0x65, 0x61, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x65,
+	0x61, 0x6d, 0x52, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1a,
+	0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x69, 0x6e, 0x6b, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x73, 0x69, 0x6e,
+	0x6b, 0x73, 0x22, 0x95, 0x01, 0x0a, 0x04, 0x54, 0x65, 0x61, 0x6d, 0x12, 0x17, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x09, 0xfa, 0x42, 0x06, 0x72, 0x04, 0x10, 0x03, 0x18, 0x64, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18,
+	0x90, 0x4e, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x29, 0x0a, 0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0xff, 0x01, 0x52, 0x0a,
+	0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x32, 0xa8, 0x14, 0x0a, 0x0a, 0x4f,
+	0x63, 0x70, 0x54, 0x65, 0x61, 0x6d, 0x41, 0x70, 0x69, 0x12, 0x6b, 0x0a, 0x0c, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x56, 0x31, 0x12, 0x21, 0x2e, 0x6f, 0x63, 0x70, 0x2e,
+	0x74, 0x65, 0x61, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54,
+	0x65, 0x61, 0x6d, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6f,
+	0x63, 0x70, 0x2e, 0x74, 0x65, 0x61, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x14, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x22, 0x09, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x65,
+	0x61, 0x6d, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x85, 0x01, 0x0a, 0x11, 0x4d, 0x75, 0x6c, 0x74, 0x69,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x56, 0x31, 0x12, 0x26, 0x2e, 0x6f,
+	0x63, 0x70, 0x2e, 0x74, 0x65, 0x61, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x75, 0x6c, 0x74,
+	0x69, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x56, 0x31, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x74, 0x65, 0x61, 0x6d, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54,
+	0x65, 0x61, 0x6d, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x19, 0x22, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x65, 0x61, 0x6d, 0x73,
+	0x2f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x01, 0x2a, 0x12, 0x79,
+	0x0a, 0x0d, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x73, 0x56, 0x31, 0x12,
+	0x22, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x74, 0x65, 0x61, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55,
+	0x70, 0x73, 0x65, 0x72, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x73, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x74, 0x65, 0x61, 0x6d, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x73, 0x56, 0x31,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19,
+	0x1a, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x2f, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x01, 0x2a, 0x12, 0x64, 0x0a, 0x09, 0x47, 0x65, 0x74,
+	0x54, 0x65, 0x61, 0x6d, 0x56, 0x31, 0x12, 0x1e, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x74, 0x65, 0x61,
+	0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x56, 0x31, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x74, 0x65, 0x61,
+	0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x56, 0x31, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x12,
+	0x0e, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12,
+	0x65, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x73, 0x56, 0x31, 0x12, 0x20,
+	0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x74, 0x65, 0x61, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x73, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x21, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x74, 0x65, 0x61, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x73, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x11, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0b, 0x12, 0x09, 0x2f, 0x76, 0x31,
+	0x2f, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x12, 0x6d, 0x0a, 0x0c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x54, 0x65, 0x61, 0x6d, 0x56, 0x31, 0x12, 0x21, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x74, 0x65, 0x61,
+	0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x65, 0x61, 0x6d,
+	0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6f, 0x63, 0x70, 0x2e,
+	0x74, 0x65, 0x61, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54,
+	0x65, 0x61, 0x6d, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x16, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x10, 0x2a, 0x0e, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x65, 0x61, 0x6d, 0x73,
+	0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x6b, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54,
+	0x65, 0x61, 0x6d, 0x56, 0x31, 0x12, 0x21, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x74, 0x65, 0x61, 0x6d,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x56,
+	0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x74,
+	0x65, 0x61, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65,
+	0x61, 0x6d, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x14, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x0e, 0x1a, 0x09, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x3a,
+	0x01, 0x2a, 0x12, 0x73, 0x0a, 0x0d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x54, 0x65, 0x61, 0x6d,
+	0x73, 0x56, 0x31, 0x12, 0x21, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x74, 0x65, 0x61, 0x6d, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x54, 0x65, 0x61, 0x6d, 0x56, 0x31, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x74, 0x65, 0x61,
+	0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x54, 0x65, 0x61, 0x6d,
+	0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x15, 0x22, 0x10, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x2f, 0x73, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x3a, 0x01, 0x2a, 0x12, 0x79, 0x0a, 0x0e, 0x53, 0x75, 0x67, 0x67, 0x65,
+	0x73, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x73, 0x56, 0x31, 0x12, 0x23, 0x2e, 0x6f, 0x63, 0x70, 0x2e,
+	0x74, 0x65, 0x61, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74,
+	0x54, 0x65, 0x61, 0x6d, 0x73, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24,
+	0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x74, 0x65, 0x61, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x75,
+	0x67, 0x67, 0x65, 0x73, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x73, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x22, 0x11, 0x2f, 0x76,
+	0x31, 0x2f, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x2f, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x3a,
+	0x01, 0x2a, 0x12, 0x71, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x61, 0x6d,
+	0x41, 0x73, 0x79, 0x6e, 0x63, 0x56, 0x31, 0x12, 0x21, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x74, 0x65,
+	0x61, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x61,
+	0x6d, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x6c, 0x6f, 0x6e, 0x67, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x14, 0x22, 0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x2f, 0x61, 0x73, 0x79,
+	0x6e, 0x63, 0x3a, 0x01, 0x2a, 0x12, 0x86, 0x01, 0x0a, 0x16, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x56, 0x31,
+	0x12, 0x26, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x74, 0x65, 0x61, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x4d, 0x75, 0x6c, 0x74, 0x69, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x56,
+	0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x6c, 0x6f, 0x6e, 0x67, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x22,
+	0x1a, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x2f, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x61, 0x73, 0x79, 0x6e, 0x63, 0x3a, 0x01, 0x2a, 0x12, 0x75,
+	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x31,
+	0x12, 0x23, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x74, 0x65, 0x61, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x47, 0x65, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x31, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x6c,
+	0x6f, 0x6e, 0x67, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x76,
+	0x31, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x3d, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x2f, 0x2a, 0x7d, 0x12, 0x79, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x56, 0x31, 0x12, 0x25, 0x2e, 0x6f, 0x63, 0x70, 0x2e,
+	0x74, 0x65, 0x61, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x26, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x74, 0x65, 0x61, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x56, 0x31,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10,
+	0x12, 0x0e, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x77, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x56, 0x31, 0x12, 0x24, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x74, 0x65, 0x61, 0x6d, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b,
+	0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6f, 0x63, 0x70, 0x2e,
+	0x74, 0x65, 0x61, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57,
+	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x22, 0x0c, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x65,
+	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x71, 0x0a, 0x0e, 0x4c, 0x69, 0x73,
+	0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x56, 0x31, 0x12, 0x23, 0x2e, 0x6f, 0x63,
+	0x70, 0x2e, 0x74, 0x65, 0x61, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57,
+	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x24, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x74, 0x65, 0x61, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x56, 0x31, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x14, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x12, 0x0c,
+	0x2f, 0x76, 0x31, 0x2f, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x79, 0x0a, 0x0f,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x56, 0x31, 0x12,
+	0x24, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x74, 0x65, 0x61, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x56, 0x31, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x74, 0x65, 0x61, 0x6d,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f,
+	0x6f, 0x6b, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x13, 0x2a, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0xa4, 0x01, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74,
+	0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65,
+	0x73, 0x56, 0x31, 0x12, 0x2c, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x74, 0x65, 0x61, 0x6d, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2d, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x74, 0x65, 0x61, 0x6d, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x12, 0x24, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x65,
+	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x7b, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x5f,
+	0x69, 0x64, 0x7d, 0x2f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x7e,
+	0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72,
+	0x73, 0x56, 0x31, 0x12, 0x26, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x74, 0x65, 0x61, 0x6d, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65,
+	0x72, 0x73, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6f, 0x63,
+	0x70, 0x2e, 0x74, 0x65, 0x61, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44,
+	0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x12, 0x10, 0x2f, 0x76,
+	0x31, 0x2f, 0x64, 0x65, 0x61, 0x64, 0x2d, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x12, 0x7d,
+	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x56,
+	0x31, 0x12, 0x24, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x74, 0x65, 0x61, 0x6d, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x56, 0x31,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x74, 0x65,
+	0x61, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65,
+	0x74, 0x74, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x65, 0x61, 0x64,
+	0x2d, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x8c, 0x01,
+	0x0a, 0x11, 0x52, 0x65, 0x74, 0x72, 0x79, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65,
+	0x72, 0x56, 0x31, 0x12, 0x26, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x74, 0x65, 0x61, 0x6d, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74,
+	0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6f, 0x63,
+	0x70, 0x2e, 0x74, 0x65, 0x61, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79,
+	0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x22, 0x1b, 0x2f, 0x76,
+	0x31, 0x2f, 0x64, 0x65, 0x61, 0x64, 0x2d, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b,
+	0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x74, 0x72, 0x79, 0x3a, 0x01, 0x2a, 0x12, 0x86, 0x01, 0x0a,
+	0x12, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65,
+	0x72, 0x56, 0x31, 0x12, 0x27, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x74, 0x65, 0x61, 0x6d, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74,
+	0x74, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6f,
+	0x63, 0x70, 0x2e, 0x74, 0x65, 0x61, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x2a, 0x15,
+	0x2f, 0x76, 0x31, 0x2f, 0x64, 0x65, 0x61, 0x64, 0x2d, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73,
+	0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x42, 0x3e, 0x5a, 0x3c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x7a, 0x6f, 0x6e, 0x63, 0x70, 0x2f, 0x6f, 0x63, 0x70, 0x2d, 0x74,
+	0x65, 0x61, 0x6d, 0x2d, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x6f, 0x63, 0x70, 0x2d,
+	0x74, 0x65, 0x61, 0x6d, 0x2d, 0x61, 0x70, 0x69, 0x3b, 0x6f, 0x63, 0x70, 0x5f, 0x74, 0x65, 0x61,
+	0x6d, 0x5f, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -949,52 +3161,125 @@ func file_api_ocp_team_api_ocp_team_api_proto_rawDescGZIP() []byte {
 	return file_api_ocp_team_api_ocp_team_api_proto_rawDescData
 }
 
-var file_api_ocp_team_api_ocp_team_api_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_api_ocp_team_api_ocp_team_api_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_api_ocp_team_api_ocp_team_api_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_api_ocp_team_api_ocp_team_api_proto_msgTypes = make([]protoimpl.MessageInfo, 45)
 var file_api_ocp_team_api_ocp_team_api_proto_goTypes = []interface{}{
-	(SearchTeamV1Request_Type)(0),     // 0: ocp.team.api.SearchTeamV1Request.Type
-	(*CreateTeamV1Request)(nil),       // 1: ocp.team.api.CreateTeamV1Request
-	(*CreateTeamV1Response)(nil),      // 2: ocp.team.api.CreateTeamV1Response
-	(*MultiCreateTeamV1Request)(nil),  // 3: ocp.team.api.MultiCreateTeamV1Request
-	(*MultiCreateTeamV1Response)(nil), // 4: ocp.team.api.MultiCreateTeamV1Response
-	(*GetTeamV1Request)(nil),          // 5: ocp.team.api.GetTeamV1Request
-	(*GetTeamV1Response)(nil),         // 6: ocp.team.api.GetTeamV1Response
-	(*ListTeamsV1Request)(nil),        // 7: ocp.team.api.ListTeamsV1Request
-	(*ListTeamsV1Response)(nil),       // 8: ocp.team.api.ListTeamsV1Response
-	(*RemoveTeamV1Request)(nil),       // 9: ocp.team.api.RemoveTeamV1Request
-	(*RemoveTeamV1Response)(nil),      // 10: ocp.team.api.RemoveTeamV1Response
-	(*UpdateTeamV1Request)(nil),       // 11: ocp.team.api.UpdateTeamV1Request
-	(*UpdateTeamV1Response)(nil),      // 12: ocp.team.api.UpdateTeamV1Response
-	(*SearchTeamV1Request)(nil),       // 13: ocp.team.api.SearchTeamV1Request
-	(*SearchTeamV1Response)(nil),      // 14: ocp.team.api.SearchTeamV1Response
-	(*Team)(nil),                      // 15: ocp.team.api.Team
+	(UpsertTeamsV1Response_Status)(0),       // 0: ocp.team.api.UpsertTeamsV1Response.Status
+	(SearchTeamV1Request_Type)(0),           // 1: ocp.team.api.SearchTeamV1Request.Type
+	(OperationMetadataV1_State)(0),          // 2: ocp.team.api.OperationMetadataV1.State
+	(*CreateTeamV1Request)(nil),             // 3: ocp.team.api.CreateTeamV1Request
+	(*CreateTeamV1Response)(nil),            // 4: ocp.team.api.CreateTeamV1Response
+	(*MultiCreateTeamV1Request)(nil),        // 5: ocp.team.api.MultiCreateTeamV1Request
+	(*MultiCreateTeamV1Response)(nil),       // 6: ocp.team.api.MultiCreateTeamV1Response
+	(*UpsertTeamsV1Request)(nil),            // 7: ocp.team.api.UpsertTeamsV1Request
+	(*UpsertTeamsV1Response)(nil),           // 8: ocp.team.api.UpsertTeamsV1Response
+	(*GetTeamV1Request)(nil),                // 9: ocp.team.api.GetTeamV1Request
+	(*GetTeamV1Response)(nil),               // 10: ocp.team.api.GetTeamV1Response
+	(*ListTeamsV1Request)(nil),              // 11: ocp.team.api.ListTeamsV1Request
+	(*ListTeamsV1Response)(nil),             // 12: ocp.team.api.ListTeamsV1Response
+	(*RemoveTeamV1Request)(nil),             // 13: ocp.team.api.RemoveTeamV1Request
+	(*RemoveTeamV1Response)(nil),            // 14: ocp.team.api.RemoveTeamV1Response
+	(*UpdateTeamV1Request)(nil),             // 15: ocp.team.api.UpdateTeamV1Request
+	(*UpdateTeamV1Response)(nil),            // 16: ocp.team.api.UpdateTeamV1Response
+	(*SearchTeamV1Request)(nil),             // 17: ocp.team.api.SearchTeamV1Request
+	(*SearchTeamV1Response)(nil),            // 18: ocp.team.api.SearchTeamV1Response
+	(*SuggestTeamsV1Request)(nil),           // 19: ocp.team.api.SuggestTeamsV1Request
+	(*SuggestTeamsV1Response)(nil),          // 20: ocp.team.api.SuggestTeamsV1Response
+	(*OperationMetadataV1)(nil),             // 21: ocp.team.api.OperationMetadataV1
+	(*GetOperationV1Request)(nil),           // 22: ocp.team.api.GetOperationV1Request
+	(*ListOperationsV1Request)(nil),         // 23: ocp.team.api.ListOperationsV1Request
+	(*ListOperationsV1Response)(nil),        // 24: ocp.team.api.ListOperationsV1Response
+	(*CreateWebhookV1Request)(nil),          // 25: ocp.team.api.CreateWebhookV1Request
+	(*CreateWebhookV1Response)(nil),         // 26: ocp.team.api.CreateWebhookV1Response
+	(*ListWebhooksV1Request)(nil),           // 27: ocp.team.api.ListWebhooksV1Request
+	(*ListWebhooksV1Response)(nil),          // 28: ocp.team.api.ListWebhooksV1Response
+	(*RemoveWebhookV1Request)(nil),          // 29: ocp.team.api.RemoveWebhookV1Request
+	(*RemoveWebhookV1Response)(nil),         // 30: ocp.team.api.RemoveWebhookV1Response
+	(*ListWebhookDeliveriesV1Request)(nil),  // 31: ocp.team.api.ListWebhookDeliveriesV1Request
+	(*ListWebhookDeliveriesV1Response)(nil), // 32: ocp.team.api.ListWebhookDeliveriesV1Response
+	(*Webhook)(nil),                         // 33: ocp.team.api.Webhook
+	(*WebhookDelivery)(nil),                 // 34: ocp.team.api.WebhookDelivery
+	(*ListDeadLettersV1Request)(nil),        // 35: ocp.team.api.ListDeadLettersV1Request
+	(*ListDeadLettersV1Response)(nil),       // 36: ocp.team.api.ListDeadLettersV1Response
+	(*GetDeadLetterV1Request)(nil),          // 37: ocp.team.api.GetDeadLetterV1Request
+	(*GetDeadLetterV1Response)(nil),         // 38: ocp.team.api.GetDeadLetterV1Response
+	(*RetryDeadLetterV1Request)(nil),        // 39: ocp.team.api.RetryDeadLetterV1Request
+	(*RetryDeadLetterV1Response)(nil),       // 40: ocp.team.api.RetryDeadLetterV1Response
+	(*RemoveDeadLetterV1Request)(nil),       // 41: ocp.team.api.RemoveDeadLetterV1Request
+	(*RemoveDeadLetterV1Response)(nil),      // 42: ocp.team.api.RemoveDeadLetterV1Response
+	(*DeadLetter)(nil),                      // 43: ocp.team.api.DeadLetter
+	(*Team)(nil),                            // 44: ocp.team.api.Team
+	(*UpsertTeamsV1Request_Team)(nil),       // 45: ocp.team.api.UpsertTeamsV1Request.Team
+	(*UpsertTeamsV1Response_Result)(nil),    // 46: ocp.team.api.UpsertTeamsV1Response.Result
+	(*SearchTeamV1Response_Hit)(nil),        // 47: ocp.team.api.SearchTeamV1Response.Hit
+	(*longrunning.Operation)(nil),           // 48: google.longrunning.Operation
 }
 var file_api_ocp_team_api_ocp_team_api_proto_depIdxs = []int32{
-	1,  // 0: ocp.team.api.MultiCreateTeamV1Request.teams:type_name -> ocp.team.api.CreateTeamV1Request
-	15, // 1: ocp.team.api.GetTeamV1Response.team:type_name -> ocp.team.api.Team
-	15, // 2: ocp.team.api.ListTeamsV1Response.teams:type_name -> ocp.team.api.Team
-	15, // 3: ocp.team.api.UpdateTeamV1Request.team:type_name -> ocp.team.api.Team
-	0,  // 4: ocp.team.api.SearchTeamV1Request.type:type_name -> ocp.team.api.SearchTeamV1Request.Type
-	15, // 5: ocp.team.api.SearchTeamV1Response.teams:type_name -> ocp.team.api.Team
-	1,  // 6: ocp.team.api.OcpTeamApi.CreateTeamV1:input_type -> ocp.team.api.CreateTeamV1Request
-	3,  // 7: ocp.team.api.OcpTeamApi.MultiCreateTeamV1:input_type -> ocp.team.api.MultiCreateTeamV1Request
-	5,  // 8: ocp.team.api.OcpTeamApi.GetTeamV1:input_type -> ocp.team.api.GetTeamV1Request
-	7,  // 9: ocp.team.api.OcpTeamApi.ListTeamsV1:input_type -> ocp.team.api.ListTeamsV1Request
-	9,  // 10: ocp.team.api.OcpTeamApi.RemoveTeamV1:input_type -> ocp.team.api.RemoveTeamV1Request
-	11, // 11: ocp.team.api.OcpTeamApi.UpdateTeamV1:input_type -> ocp.team.api.UpdateTeamV1Request
-	13, // 12: ocp.team.api.OcpTeamApi.SearchTeamsV1:input_type -> ocp.team.api.SearchTeamV1Request
-	2,  // 13: ocp.team.api.OcpTeamApi.CreateTeamV1:output_type -> ocp.team.api.CreateTeamV1Response
-	4,  // 14: ocp.team.api.OcpTeamApi.MultiCreateTeamV1:output_type -> ocp.team.api.MultiCreateTeamV1Response
-	6,  // 15: ocp.team.api.OcpTeamApi.GetTeamV1:output_type -> ocp.team.api.GetTeamV1Response
-	8,  // 16: ocp.team.api.OcpTeamApi.ListTeamsV1:output_type -> ocp.team.api.ListTeamsV1Response
-	10, // 17: ocp.team.api.OcpTeamApi.RemoveTeamV1:output_type -> ocp.team.api.RemoveTeamV1Response
-	12, // 18: ocp.team.api.OcpTeamApi.UpdateTeamV1:output_type -> ocp.team.api.UpdateTeamV1Response
-	14, // 19: ocp.team.api.OcpTeamApi.SearchTeamsV1:output_type -> ocp.team.api.SearchTeamV1Response
-	13, // [13:20] is the sub-list for method output_type
-	6,  // [6:13] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	3,  // 0: ocp.team.api.MultiCreateTeamV1Request.teams:type_name -> ocp.team.api.CreateTeamV1Request
+	45, // 1: ocp.team.api.UpsertTeamsV1Request.teams:type_name -> ocp.team.api.UpsertTeamsV1Request.Team
+	46, // 2: ocp.team.api.UpsertTeamsV1Response.results:type_name -> ocp.team.api.UpsertTeamsV1Response.Result
+	44, // 3: ocp.team.api.GetTeamV1Response.team:type_name -> ocp.team.api.Team
+	44, // 4: ocp.team.api.ListTeamsV1Response.teams:type_name -> ocp.team.api.Team
+	44, // 5: ocp.team.api.UpdateTeamV1Request.team:type_name -> ocp.team.api.Team
+	1,  // 6: ocp.team.api.SearchTeamV1Request.type:type_name -> ocp.team.api.SearchTeamV1Request.Type
+	44, // 7: ocp.team.api.SearchTeamV1Response.teams:type_name -> ocp.team.api.Team
+	47, // 8: ocp.team.api.SearchTeamV1Response.hits:type_name -> ocp.team.api.SearchTeamV1Response.Hit
+	2,  // 9: ocp.team.api.OperationMetadataV1.state:type_name -> ocp.team.api.OperationMetadataV1.State
+	48, // 10: ocp.team.api.ListOperationsV1Response.operations:type_name -> google.longrunning.Operation
+	33, // 11: ocp.team.api.ListWebhooksV1Response.webhooks:type_name -> ocp.team.api.Webhook
+	34, // 12: ocp.team.api.ListWebhookDeliveriesV1Response.deliveries:type_name -> ocp.team.api.WebhookDelivery
+	43, // 13: ocp.team.api.ListDeadLettersV1Response.dead_letters:type_name -> ocp.team.api.DeadLetter
+	43, // 14: ocp.team.api.GetDeadLetterV1Response.dead_letter:type_name -> ocp.team.api.DeadLetter
+	44, // 15: ocp.team.api.DeadLetter.team:type_name -> ocp.team.api.Team
+	0,  // 16: ocp.team.api.UpsertTeamsV1Response.Result.status:type_name -> ocp.team.api.UpsertTeamsV1Response.Status
+	44, // 17: ocp.team.api.SearchTeamV1Response.Hit.team:type_name -> ocp.team.api.Team
+	3,  // 18: ocp.team.api.OcpTeamApi.CreateTeamV1:input_type -> ocp.team.api.CreateTeamV1Request
+	5,  // 19: ocp.team.api.OcpTeamApi.MultiCreateTeamV1:input_type -> ocp.team.api.MultiCreateTeamV1Request
+	7,  // 20: ocp.team.api.OcpTeamApi.UpsertTeamsV1:input_type -> ocp.team.api.UpsertTeamsV1Request
+	9,  // 21: ocp.team.api.OcpTeamApi.GetTeamV1:input_type -> ocp.team.api.GetTeamV1Request
+	11, // 22: ocp.team.api.OcpTeamApi.ListTeamsV1:input_type -> ocp.team.api.ListTeamsV1Request
+	13, // 23: ocp.team.api.OcpTeamApi.RemoveTeamV1:input_type -> ocp.team.api.RemoveTeamV1Request
+	15, // 24: ocp.team.api.OcpTeamApi.UpdateTeamV1:input_type -> ocp.team.api.UpdateTeamV1Request
+	17, // 25: ocp.team.api.OcpTeamApi.SearchTeamsV1:input_type -> ocp.team.api.SearchTeamV1Request
+	19, // 26: ocp.team.api.OcpTeamApi.SuggestTeamsV1:input_type -> ocp.team.api.SuggestTeamsV1Request
+	3,  // 27: ocp.team.api.OcpTeamApi.CreateTeamAsyncV1:input_type -> ocp.team.api.CreateTeamV1Request
+	5,  // 28: ocp.team.api.OcpTeamApi.MultiCreateTeamAsyncV1:input_type -> ocp.team.api.MultiCreateTeamV1Request
+	22, // 29: ocp.team.api.OcpTeamApi.GetOperationV1:input_type -> ocp.team.api.GetOperationV1Request
+	23, // 30: ocp.team.api.OcpTeamApi.ListOperationsV1:input_type -> ocp.team.api.ListOperationsV1Request
+	25, // 31: ocp.team.api.OcpTeamApi.CreateWebhookV1:input_type -> ocp.team.api.CreateWebhookV1Request
+	27, // 32: ocp.team.api.OcpTeamApi.ListWebhooksV1:input_type -> ocp.team.api.ListWebhooksV1Request
+	29, // 33: ocp.team.api.OcpTeamApi.RemoveWebhookV1:input_type -> ocp.team.api.RemoveWebhookV1Request
+	31, // 34: ocp.team.api.OcpTeamApi.ListWebhookDeliveriesV1:input_type -> ocp.team.api.ListWebhookDeliveriesV1Request
+	35, // 35: ocp.team.api.OcpTeamApi.ListDeadLettersV1:input_type -> ocp.team.api.ListDeadLettersV1Request
+	37, // 36: ocp.team.api.OcpTeamApi.GetDeadLetterV1:input_type -> ocp.team.api.GetDeadLetterV1Request
+	39, // 37: ocp.team.api.OcpTeamApi.RetryDeadLetterV1:input_type -> ocp.team.api.RetryDeadLetterV1Request
+	41, // 38: ocp.team.api.OcpTeamApi.RemoveDeadLetterV1:input_type -> ocp.team.api.RemoveDeadLetterV1Request
+	4,  // 39: ocp.team.api.OcpTeamApi.CreateTeamV1:output_type -> ocp.team.api.CreateTeamV1Response
+	6,  // 40: ocp.team.api.OcpTeamApi.MultiCreateTeamV1:output_type -> ocp.team.api.MultiCreateTeamV1Response
+	8,  // 41: ocp.team.api.OcpTeamApi.UpsertTeamsV1:output_type -> ocp.team.api.UpsertTeamsV1Response
+	10, // 42: ocp.team.api.OcpTeamApi.GetTeamV1:output_type -> ocp.team.api.GetTeamV1Response
+	12, // 43: ocp.team.api.OcpTeamApi.ListTeamsV1:output_type -> ocp.team.api.ListTeamsV1Response
+	14, // 44: ocp.team.api.OcpTeamApi.RemoveTeamV1:output_type -> ocp.team.api.RemoveTeamV1Response
+	16, // 45: ocp.team.api.OcpTeamApi.UpdateTeamV1:output_type -> ocp.team.api.UpdateTeamV1Response
+	18, // 46: ocp.team.api.OcpTeamApi.SearchTeamsV1:output_type -> ocp.team.api.SearchTeamV1Response
+	20, // 47: ocp.team.api.OcpTeamApi.SuggestTeamsV1:output_type -> ocp.team.api.SuggestTeamsV1Response
+	48, // 48: ocp.team.api.OcpTeamApi.CreateTeamAsyncV1:output_type -> google.longrunning.Operation
+	48, // 49: ocp.team.api.OcpTeamApi.MultiCreateTeamAsyncV1:output_type -> google.longrunning.Operation
+	48, // 50: ocp.team.api.OcpTeamApi.GetOperationV1:output_type -> google.longrunning.Operation
+	24, // 51: ocp.team.api.OcpTeamApi.ListOperationsV1:output_type -> ocp.team.api.ListOperationsV1Response
+	26, // 52: ocp.team.api.OcpTeamApi.CreateWebhookV1:output_type -> ocp.team.api.CreateWebhookV1Response
+	28, // 53: ocp.team.api.OcpTeamApi.ListWebhooksV1:output_type -> ocp.team.api.ListWebhooksV1Response
+	30, // 54: ocp.team.api.OcpTeamApi.RemoveWebhookV1:output_type -> ocp.team.api.RemoveWebhookV1Response
+	32, // 55: ocp.team.api.OcpTeamApi.ListWebhookDeliveriesV1:output_type -> ocp.team.api.ListWebhookDeliveriesV1Response
+	36, // 56: ocp.team.api.OcpTeamApi.ListDeadLettersV1:output_type -> ocp.team.api.ListDeadLettersV1Response
+	38, // 57: ocp.team.api.OcpTeamApi.GetDeadLetterV1:output_type -> ocp.team.api.GetDeadLetterV1Response
+	40, // 58: ocp.team.api.OcpTeamApi.RetryDeadLetterV1:output_type -> ocp.team.api.RetryDeadLetterV1Response
+	42, // 59: ocp.team.api.OcpTeamApi.RemoveDeadLetterV1:output_type -> ocp.team.api.RemoveDeadLetterV1Response
+	39, // [39:60] is the sub-list for method output_type
+	18, // [18:39] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
 }
 
 func init() { file_api_ocp_team_api_ocp_team_api_proto_init() }
@@ -1015,8 +3300,116 @@ func file_api_ocp_team_api_ocp_team_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_ocp_team_api_ocp_team_api_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateTeamV1Response); i {
+		file_api_ocp_team_api_ocp_team_api_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateTeamV1Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_team_api_ocp_team_api_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MultiCreateTeamV1Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_team_api_ocp_team_api_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MultiCreateTeamV1Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_team_api_ocp_team_api_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpsertTeamsV1Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_team_api_ocp_team_api_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpsertTeamsV1Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_team_api_ocp_team_api_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTeamV1Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_team_api_ocp_team_api_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTeamV1Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_team_api_ocp_team_api_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTeamsV1Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_team_api_ocp_team_api_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTeamsV1Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_team_api_ocp_team_api_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveTeamV1Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1027,8 +3420,8 @@ func file_api_ocp_team_api_ocp_team_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_ocp_team_api_ocp_team_api_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MultiCreateTeamV1Request); i {
+		file_api_ocp_team_api_ocp_team_api_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveTeamV1Response); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1039,8 +3432,8 @@ func file_api_ocp_team_api_ocp_team_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_ocp_team_api_ocp_team_api_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MultiCreateTeamV1Response); i {
+		file_api_ocp_team_api_ocp_team_api_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateTeamV1Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1051,8 +3444,8 @@ func file_api_ocp_team_api_ocp_team_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_ocp_team_api_ocp_team_api_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTeamV1Request); i {
+		file_api_ocp_team_api_ocp_team_api_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateTeamV1Response); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1063,8 +3456,8 @@ func file_api_ocp_team_api_ocp_team_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_ocp_team_api_ocp_team_api_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTeamV1Response); i {
+		file_api_ocp_team_api_ocp_team_api_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchTeamV1Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1075,8 +3468,8 @@ func file_api_ocp_team_api_ocp_team_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_ocp_team_api_ocp_team_api_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListTeamsV1Request); i {
+		file_api_ocp_team_api_ocp_team_api_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchTeamV1Response); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1087,8 +3480,8 @@ func file_api_ocp_team_api_ocp_team_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_ocp_team_api_ocp_team_api_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListTeamsV1Response); i {
+		file_api_ocp_team_api_ocp_team_api_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuggestTeamsV1Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1099,8 +3492,8 @@ func file_api_ocp_team_api_ocp_team_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_ocp_team_api_ocp_team_api_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveTeamV1Request); i {
+		file_api_ocp_team_api_ocp_team_api_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuggestTeamsV1Response); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1111,8 +3504,8 @@ func file_api_ocp_team_api_ocp_team_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_ocp_team_api_ocp_team_api_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveTeamV1Response); i {
+		file_api_ocp_team_api_ocp_team_api_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OperationMetadataV1); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1123,8 +3516,8 @@ func file_api_ocp_team_api_ocp_team_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_ocp_team_api_ocp_team_api_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateTeamV1Request); i {
+		file_api_ocp_team_api_ocp_team_api_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetOperationV1Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1135,8 +3528,8 @@ func file_api_ocp_team_api_ocp_team_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_ocp_team_api_ocp_team_api_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateTeamV1Response); i {
+		file_api_ocp_team_api_ocp_team_api_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListOperationsV1Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1147,8 +3540,8 @@ func file_api_ocp_team_api_ocp_team_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_ocp_team_api_ocp_team_api_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchTeamV1Request); i {
+		file_api_ocp_team_api_ocp_team_api_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListOperationsV1Response); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1159,8 +3552,8 @@ func file_api_ocp_team_api_ocp_team_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_ocp_team_api_ocp_team_api_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchTeamV1Response); i {
+		file_api_ocp_team_api_ocp_team_api_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateWebhookV1Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1171,7 +3564,223 @@ func file_api_ocp_team_api_ocp_team_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_ocp_team_api_ocp_team_api_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+		file_api_ocp_team_api_ocp_team_api_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateWebhookV1Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_team_api_ocp_team_api_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWebhooksV1Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_team_api_ocp_team_api_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWebhooksV1Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_team_api_ocp_team_api_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveWebhookV1Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_team_api_ocp_team_api_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveWebhookV1Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_team_api_ocp_team_api_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWebhookDeliveriesV1Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_team_api_ocp_team_api_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWebhookDeliveriesV1Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_team_api_ocp_team_api_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Webhook); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_team_api_ocp_team_api_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebhookDelivery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_team_api_ocp_team_api_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDeadLettersV1Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_team_api_ocp_team_api_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDeadLettersV1Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_team_api_ocp_team_api_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDeadLetterV1Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_team_api_ocp_team_api_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDeadLetterV1Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_team_api_ocp_team_api_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RetryDeadLetterV1Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_team_api_ocp_team_api_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RetryDeadLetterV1Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_team_api_ocp_team_api_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveDeadLetterV1Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_team_api_ocp_team_api_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveDeadLetterV1Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_team_api_ocp_team_api_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeadLetter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_team_api_ocp_team_api_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Team); i {
 			case 0:
 				return &v.state
@@ -1183,14 +3792,50 @@ func file_api_ocp_team_api_ocp_team_api_proto_init() {
 				return nil
 			}
 		}
+		file_api_ocp_team_api_ocp_team_api_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpsertTeamsV1Request_Team); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_team_api_ocp_team_api_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpsertTeamsV1Response_Result); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_team_api_ocp_team_api_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchTeamV1Response_Hit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_ocp_team_api_ocp_team_api_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   15,
+			NumEnums:      3,
+			NumMessages:   45,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -339,6 +339,177 @@ var _ interface {
 	ErrorName() string
 } = MultiCreateTeamV1ResponseValidationError{}
 
+// Validate checks the field values on UpsertTeamsV1Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *UpsertTeamsV1Request) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if len(m.GetTeams()) < 1 {
+		return UpsertTeamsV1RequestValidationError{
+			field:  "Teams",
+			reason: "value must contain at least 1 item(s)",
+		}
+	}
+
+	for idx, item := range m.GetTeams() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpsertTeamsV1RequestValidationError{
+					field:  fmt.Sprintf("Teams[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// UpsertTeamsV1RequestValidationError is the validation error returned by
+// UpsertTeamsV1Request.Validate if the designated constraints aren't met.
+type UpsertTeamsV1RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpsertTeamsV1RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpsertTeamsV1RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpsertTeamsV1RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpsertTeamsV1RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpsertTeamsV1RequestValidationError) ErrorName() string {
+	return "UpsertTeamsV1RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpsertTeamsV1RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpsertTeamsV1Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpsertTeamsV1RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpsertTeamsV1RequestValidationError{}
+
+// Validate checks the field values on UpsertTeamsV1Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *UpsertTeamsV1Response) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpsertTeamsV1ResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// UpsertTeamsV1ResponseValidationError is the validation error returned by
+// UpsertTeamsV1Response.Validate if the designated constraints aren't met.
+type UpsertTeamsV1ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpsertTeamsV1ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpsertTeamsV1ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpsertTeamsV1ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpsertTeamsV1ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpsertTeamsV1ResponseValidationError) ErrorName() string {
+	return "UpsertTeamsV1ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpsertTeamsV1ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpsertTeamsV1Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpsertTeamsV1ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpsertTeamsV1ResponseValidationError{}
+
 // Validate checks the field values on GetTeamV1Request with the rules defined
 // in the proto definition for this message. If any rules are violated, an
 // error is returned.
@@ -950,6 +1121,22 @@ func (m *SearchTeamV1Request) Validate() error {
 
 	// no validation rules for Query
 
+	if m.GetLimit() > 100 {
+		return SearchTeamV1RequestValidationError{
+			field:  "Limit",
+			reason: "value must be less than or equal to 100",
+		}
+	}
+
+	// no validation rules for PageToken
+
+	if m.GetMinScore() < 0 {
+		return SearchTeamV1RequestValidationError{
+			field:  "MinScore",
+			reason: "value must be greater than or equal to 0",
+		}
+	}
+
 	return nil
 }
 
@@ -1032,6 +1219,25 @@ func (m *SearchTeamV1Response) Validate() error {
 
 	}
 
+	for idx, item := range m.GetHits() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchTeamV1ResponseValidationError{
+					field:  fmt.Sprintf("Hits[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	// no validation rules for NextPageToken
+
 	return nil
 }
 
@@ -1091,40 +1297,101 @@ var _ interface {
 	ErrorName() string
 } = SearchTeamV1ResponseValidationError{}
 
-// Validate checks the field values on Team with the rules defined in the proto
-// definition for this message. If any rules are violated, an error is returned.
-func (m *Team) Validate() error {
+// Validate checks the field values on SuggestTeamsV1Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *SuggestTeamsV1Request) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	if m.GetId() <= 0 {
-		return TeamValidationError{
-			field:  "Id",
-			reason: "value must be greater than 0",
+	if l := utf8.RuneCountInString(m.GetPrefix()); l < 1 || l > 100 {
+		return SuggestTeamsV1RequestValidationError{
+			field:  "Prefix",
+			reason: "value length must be between 1 and 100 runes, inclusive",
 		}
 	}
 
-	if l := utf8.RuneCountInString(m.GetName()); l < 3 || l > 100 {
-		return TeamValidationError{
-			field:  "Name",
-			reason: "value length must be between 3 and 100 runes, inclusive",
+	if m.GetLimit() > 20 {
+		return SuggestTeamsV1RequestValidationError{
+			field:  "Limit",
+			reason: "value must be less than or equal to 20",
 		}
 	}
 
-	if utf8.RuneCountInString(m.GetDescription()) > 10000 {
-		return TeamValidationError{
-			field:  "Description",
-			reason: "value length must be at most 10000 runes",
-		}
+	return nil
+}
+
+// SuggestTeamsV1RequestValidationError is the validation error returned by
+// SuggestTeamsV1Request.Validate if the designated constraints aren't met.
+type SuggestTeamsV1RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SuggestTeamsV1RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SuggestTeamsV1RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SuggestTeamsV1RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SuggestTeamsV1RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SuggestTeamsV1RequestValidationError) ErrorName() string {
+	return "SuggestTeamsV1RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SuggestTeamsV1RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSuggestTeamsV1Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SuggestTeamsV1RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SuggestTeamsV1RequestValidationError{}
+
+// Validate checks the field values on SuggestTeamsV1Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *SuggestTeamsV1Response) Validate() error {
+	if m == nil {
+		return nil
 	}
 
 	return nil
 }
 
-// TeamValidationError is the validation error returned by Team.Validate if the
-// designated constraints aren't met.
-type TeamValidationError struct {
+// SuggestTeamsV1ResponseValidationError is the validation error returned by
+// SuggestTeamsV1Response.Validate if the designated constraints aren't met.
+type SuggestTeamsV1ResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1132,22 +1399,24 @@ type TeamValidationError struct {
 }
 
 // Field function returns field value.
-func (e TeamValidationError) Field() string { return e.field }
+func (e SuggestTeamsV1ResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TeamValidationError) Reason() string { return e.reason }
+func (e SuggestTeamsV1ResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TeamValidationError) Cause() error { return e.cause }
+func (e SuggestTeamsV1ResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TeamValidationError) Key() bool { return e.key }
+func (e SuggestTeamsV1ResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TeamValidationError) ErrorName() string { return "TeamValidationError" }
+func (e SuggestTeamsV1ResponseValidationError) ErrorName() string {
+	return "SuggestTeamsV1ResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e TeamValidationError) Error() string {
+func (e SuggestTeamsV1ResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1159,14 +1428,14 @@ func (e TeamValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTeam.%s: %s%s",
+		"invalid %sSuggestTeamsV1Response.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TeamValidationError{}
+var _ error = SuggestTeamsV1ResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1174,4 +1443,2174 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TeamValidationError{}
+} = SuggestTeamsV1ResponseValidationError{}
+
+// Validate checks the field values on OperationMetadataV1 with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *OperationMetadataV1) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for State
+
+	// no validation rules for Total
+
+	// no validation rules for Created
+
+	// no validation rules for Failed
+
+	// no validation rules for CreateTime
+
+	// no validation rules for UpdateTime
+
+	return nil
+}
+
+// OperationMetadataV1ValidationError is the validation error returned by
+// OperationMetadataV1.Validate if the designated constraints aren't met.
+type OperationMetadataV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OperationMetadataV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OperationMetadataV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OperationMetadataV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OperationMetadataV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OperationMetadataV1ValidationError) ErrorName() string {
+	return "OperationMetadataV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e OperationMetadataV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOperationMetadataV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OperationMetadataV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OperationMetadataV1ValidationError{}
+
+// Validate checks the field values on GetOperationV1Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GetOperationV1Request) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if !_GetOperationV1Request_Name_Pattern.MatchString(m.GetName()) {
+		return GetOperationV1RequestValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^operations/[^/]+$\"",
+		}
+	}
+
+	return nil
+}
+
+// GetOperationV1RequestValidationError is the validation error returned by
+// GetOperationV1Request.Validate if the designated constraints aren't met.
+type GetOperationV1RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetOperationV1RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetOperationV1RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetOperationV1RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetOperationV1RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetOperationV1RequestValidationError) ErrorName() string {
+	return "GetOperationV1RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetOperationV1RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetOperationV1Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetOperationV1RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetOperationV1RequestValidationError{}
+
+var _GetOperationV1Request_Name_Pattern = regexp.MustCompile("^operations/[^/]+$")
+
+// Validate checks the field values on ListOperationsV1Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListOperationsV1Request) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if _, ok := _ListOperationsV1Request_Filter_InLookup[m.GetFilter()]; !ok {
+		return ListOperationsV1RequestValidationError{
+			field:  "Filter",
+			reason: "value must be in list [ done=true done=false]",
+		}
+	}
+
+	if val := m.GetPageSize(); val < 0 || val > 100 {
+		return ListOperationsV1RequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [0, 100]",
+		}
+	}
+
+	// no validation rules for PageToken
+
+	return nil
+}
+
+// ListOperationsV1RequestValidationError is the validation error returned by
+// ListOperationsV1Request.Validate if the designated constraints aren't met.
+type ListOperationsV1RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListOperationsV1RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListOperationsV1RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListOperationsV1RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListOperationsV1RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListOperationsV1RequestValidationError) ErrorName() string {
+	return "ListOperationsV1RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListOperationsV1RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListOperationsV1Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListOperationsV1RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListOperationsV1RequestValidationError{}
+
+var _ListOperationsV1Request_Filter_InLookup = map[string]struct{}{
+	"":           {},
+	"done=true":  {},
+	"done=false": {},
+}
+
+// Validate checks the field values on ListOperationsV1Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListOperationsV1Response) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetOperations() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListOperationsV1ResponseValidationError{
+					field:  fmt.Sprintf("Operations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	return nil
+}
+
+// ListOperationsV1ResponseValidationError is the validation error returned by
+// ListOperationsV1Response.Validate if the designated constraints aren't met.
+type ListOperationsV1ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListOperationsV1ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListOperationsV1ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListOperationsV1ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListOperationsV1ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListOperationsV1ResponseValidationError) ErrorName() string {
+	return "ListOperationsV1ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListOperationsV1ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListOperationsV1Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListOperationsV1ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListOperationsV1ResponseValidationError{}
+
+// Validate checks the field values on CreateWebhookV1Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *CreateWebhookV1Request) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if utf8.RuneCountInString(m.GetUrl()) > 2048 {
+		return CreateWebhookV1RequestValidationError{
+			field:  "Url",
+			reason: "value length must be at most 2048 runes",
+		}
+	}
+
+	if uri, err := url.Parse(m.GetUrl()); err != nil {
+		return CreateWebhookV1RequestValidationError{
+			field:  "Url",
+			reason: "value must be a valid URI",
+			cause:  err,
+		}
+	} else if !uri.IsAbs() {
+		return CreateWebhookV1RequestValidationError{
+			field:  "Url",
+			reason: "value must be absolute",
+		}
+	}
+
+	if !_CreateWebhookV1Request_Url_Pattern.MatchString(m.GetUrl()) {
+		return CreateWebhookV1RequestValidationError{
+			field:  "Url",
+			reason: "value does not match regex pattern \"^https?://\"",
+		}
+	}
+
+	_CreateWebhookV1Request_Events_Unique := make(map[string]struct{}, len(m.GetEvents()))
+
+	for idx, item := range m.GetEvents() {
+		_, _ = idx, item
+
+		if _, exists := _CreateWebhookV1Request_Events_Unique[item]; exists {
+			return CreateWebhookV1RequestValidationError{
+				field:  fmt.Sprintf("Events[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+		} else {
+			_CreateWebhookV1Request_Events_Unique[item] = struct{}{}
+		}
+
+		if _, ok := _CreateWebhookV1Request_Events_InLookup[item]; !ok {
+			return CreateWebhookV1RequestValidationError{
+				field:  fmt.Sprintf("Events[%v]", idx),
+				reason: "value must be in list [Create Update Delete Snapshot]",
+			}
+		}
+
+	}
+
+	if l := utf8.RuneCountInString(m.GetSecret()); l < 16 || l > 256 {
+		return CreateWebhookV1RequestValidationError{
+			field:  "Secret",
+			reason: "value length must be between 16 and 256 runes, inclusive",
+		}
+	}
+
+	return nil
+}
+
+// CreateWebhookV1RequestValidationError is the validation error returned by
+// CreateWebhookV1Request.Validate if the designated constraints aren't met.
+type CreateWebhookV1RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateWebhookV1RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateWebhookV1RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateWebhookV1RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateWebhookV1RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateWebhookV1RequestValidationError) ErrorName() string {
+	return "CreateWebhookV1RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateWebhookV1RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateWebhookV1Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateWebhookV1RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateWebhookV1RequestValidationError{}
+
+var _CreateWebhookV1Request_Url_Pattern = regexp.MustCompile("^https?://")
+
+var _CreateWebhookV1Request_Events_InLookup = map[string]struct{}{
+	"Create":   {},
+	"Update":   {},
+	"Delete":   {},
+	"Snapshot": {},
+}
+
+// Validate checks the field values on CreateWebhookV1Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *CreateWebhookV1Response) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Id
+
+	return nil
+}
+
+// CreateWebhookV1ResponseValidationError is the validation error returned by
+// CreateWebhookV1Response.Validate if the designated constraints aren't met.
+type CreateWebhookV1ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateWebhookV1ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateWebhookV1ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateWebhookV1ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateWebhookV1ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateWebhookV1ResponseValidationError) ErrorName() string {
+	return "CreateWebhookV1ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateWebhookV1ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateWebhookV1Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateWebhookV1ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateWebhookV1ResponseValidationError{}
+
+// Validate checks the field values on ListWebhooksV1Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListWebhooksV1Request) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	return nil
+}
+
+// ListWebhooksV1RequestValidationError is the validation error returned by
+// ListWebhooksV1Request.Validate if the designated constraints aren't met.
+type ListWebhooksV1RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListWebhooksV1RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListWebhooksV1RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListWebhooksV1RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListWebhooksV1RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListWebhooksV1RequestValidationError) ErrorName() string {
+	return "ListWebhooksV1RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListWebhooksV1RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListWebhooksV1Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListWebhooksV1RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListWebhooksV1RequestValidationError{}
+
+// Validate checks the field values on ListWebhooksV1Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListWebhooksV1Response) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetWebhooks() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListWebhooksV1ResponseValidationError{
+					field:  fmt.Sprintf("Webhooks[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ListWebhooksV1ResponseValidationError is the validation error returned by
+// ListWebhooksV1Response.Validate if the designated constraints aren't met.
+type ListWebhooksV1ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListWebhooksV1ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListWebhooksV1ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListWebhooksV1ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListWebhooksV1ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListWebhooksV1ResponseValidationError) ErrorName() string {
+	return "ListWebhooksV1ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListWebhooksV1ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListWebhooksV1Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListWebhooksV1ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListWebhooksV1ResponseValidationError{}
+
+// Validate checks the field values on RemoveWebhookV1Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *RemoveWebhookV1Request) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.GetId() <= 0 {
+		return RemoveWebhookV1RequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+	}
+
+	return nil
+}
+
+// RemoveWebhookV1RequestValidationError is the validation error returned by
+// RemoveWebhookV1Request.Validate if the designated constraints aren't met.
+type RemoveWebhookV1RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveWebhookV1RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveWebhookV1RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveWebhookV1RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveWebhookV1RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveWebhookV1RequestValidationError) ErrorName() string {
+	return "RemoveWebhookV1RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveWebhookV1RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveWebhookV1Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveWebhookV1RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveWebhookV1RequestValidationError{}
+
+// Validate checks the field values on RemoveWebhookV1Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *RemoveWebhookV1Response) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	return nil
+}
+
+// RemoveWebhookV1ResponseValidationError is the validation error returned by
+// RemoveWebhookV1Response.Validate if the designated constraints aren't met.
+type RemoveWebhookV1ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveWebhookV1ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveWebhookV1ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveWebhookV1ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveWebhookV1ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveWebhookV1ResponseValidationError) ErrorName() string {
+	return "RemoveWebhookV1ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveWebhookV1ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveWebhookV1Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveWebhookV1ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveWebhookV1ResponseValidationError{}
+
+// Validate checks the field values on ListWebhookDeliveriesV1Request with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListWebhookDeliveriesV1Request) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.GetWebhookId() <= 0 {
+		return ListWebhookDeliveriesV1RequestValidationError{
+			field:  "WebhookId",
+			reason: "value must be greater than 0",
+		}
+	}
+
+	if val := m.GetLimit(); val <= 0 || val > 100 {
+		return ListWebhookDeliveriesV1RequestValidationError{
+			field:  "Limit",
+			reason: "value must be inside range (0, 100]",
+		}
+	}
+
+	return nil
+}
+
+// ListWebhookDeliveriesV1RequestValidationError is the validation error
+// returned by ListWebhookDeliveriesV1Request.Validate if the designated
+// constraints aren't met.
+type ListWebhookDeliveriesV1RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListWebhookDeliveriesV1RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListWebhookDeliveriesV1RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListWebhookDeliveriesV1RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListWebhookDeliveriesV1RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListWebhookDeliveriesV1RequestValidationError) ErrorName() string {
+	return "ListWebhookDeliveriesV1RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListWebhookDeliveriesV1RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListWebhookDeliveriesV1Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListWebhookDeliveriesV1RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListWebhookDeliveriesV1RequestValidationError{}
+
+// Validate checks the field values on ListWebhookDeliveriesV1Response with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListWebhookDeliveriesV1Response) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetDeliveries() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListWebhookDeliveriesV1ResponseValidationError{
+					field:  fmt.Sprintf("Deliveries[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ListWebhookDeliveriesV1ResponseValidationError is the validation error
+// returned by ListWebhookDeliveriesV1Response.Validate if the designated
+// constraints aren't met.
+type ListWebhookDeliveriesV1ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListWebhookDeliveriesV1ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListWebhookDeliveriesV1ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListWebhookDeliveriesV1ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListWebhookDeliveriesV1ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListWebhookDeliveriesV1ResponseValidationError) ErrorName() string {
+	return "ListWebhookDeliveriesV1ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListWebhookDeliveriesV1ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListWebhookDeliveriesV1Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListWebhookDeliveriesV1ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListWebhookDeliveriesV1ResponseValidationError{}
+
+// Validate checks the field values on Webhook with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *Webhook) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Id
+
+	// no validation rules for Url
+
+	// no validation rules for Enabled
+
+	// no validation rules for Failures
+
+	return nil
+}
+
+// WebhookValidationError is the validation error returned by Webhook.Validate
+// if the designated constraints aren't met.
+type WebhookValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WebhookValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WebhookValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WebhookValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WebhookValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WebhookValidationError) ErrorName() string { return "WebhookValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WebhookValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWebhook.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WebhookValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WebhookValidationError{}
+
+// Validate checks the field values on WebhookDelivery with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *WebhookDelivery) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Id
+
+	// no validation rules for WebhookId
+
+	// no validation rules for Event
+
+	// no validation rules for TeamId
+
+	// no validation rules for Success
+
+	// no validation rules for Attempts
+
+	// no validation rules for StatusCode
+
+	// no validation rules for Error
+
+	// no validation rules for DeliveredAt
+
+	return nil
+}
+
+// WebhookDeliveryValidationError is the validation error returned by
+// WebhookDelivery.Validate if the designated constraints aren't met.
+type WebhookDeliveryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WebhookDeliveryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WebhookDeliveryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WebhookDeliveryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WebhookDeliveryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WebhookDeliveryValidationError) ErrorName() string { return "WebhookDeliveryValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WebhookDeliveryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWebhookDelivery.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WebhookDeliveryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WebhookDeliveryValidationError{}
+
+// Validate checks the field values on ListDeadLettersV1Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListDeadLettersV1Request) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if val := m.GetLimit(); val <= 0 || val > 100 {
+		return ListDeadLettersV1RequestValidationError{
+			field:  "Limit",
+			reason: "value must be inside range (0, 100]",
+		}
+	}
+
+	// no validation rules for Offset
+
+	return nil
+}
+
+// ListDeadLettersV1RequestValidationError is the validation error returned by
+// ListDeadLettersV1Request.Validate if the designated constraints aren't met.
+type ListDeadLettersV1RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListDeadLettersV1RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListDeadLettersV1RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListDeadLettersV1RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListDeadLettersV1RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListDeadLettersV1RequestValidationError) ErrorName() string {
+	return "ListDeadLettersV1RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListDeadLettersV1RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListDeadLettersV1Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListDeadLettersV1RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListDeadLettersV1RequestValidationError{}
+
+// Validate checks the field values on ListDeadLettersV1Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListDeadLettersV1Response) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Total
+
+	for idx, item := range m.GetDeadLetters() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListDeadLettersV1ResponseValidationError{
+					field:  fmt.Sprintf("DeadLetters[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ListDeadLettersV1ResponseValidationError is the validation error returned by
+// ListDeadLettersV1Response.Validate if the designated constraints aren't met.
+type ListDeadLettersV1ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListDeadLettersV1ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListDeadLettersV1ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListDeadLettersV1ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListDeadLettersV1ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListDeadLettersV1ResponseValidationError) ErrorName() string {
+	return "ListDeadLettersV1ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListDeadLettersV1ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListDeadLettersV1Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListDeadLettersV1ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListDeadLettersV1ResponseValidationError{}
+
+// Validate checks the field values on GetDeadLetterV1Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GetDeadLetterV1Request) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.GetId() <= 0 {
+		return GetDeadLetterV1RequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+	}
+
+	return nil
+}
+
+// GetDeadLetterV1RequestValidationError is the validation error returned by
+// GetDeadLetterV1Request.Validate if the designated constraints aren't met.
+type GetDeadLetterV1RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDeadLetterV1RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDeadLetterV1RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDeadLetterV1RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDeadLetterV1RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDeadLetterV1RequestValidationError) ErrorName() string {
+	return "GetDeadLetterV1RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDeadLetterV1RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDeadLetterV1Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDeadLetterV1RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDeadLetterV1RequestValidationError{}
+
+// Validate checks the field values on GetDeadLetterV1Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GetDeadLetterV1Response) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetDeadLetter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetDeadLetterV1ResponseValidationError{
+				field:  "DeadLetter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// GetDeadLetterV1ResponseValidationError is the validation error returned by
+// GetDeadLetterV1Response.Validate if the designated constraints aren't met.
+type GetDeadLetterV1ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDeadLetterV1ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDeadLetterV1ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDeadLetterV1ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDeadLetterV1ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDeadLetterV1ResponseValidationError) ErrorName() string {
+	return "GetDeadLetterV1ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDeadLetterV1ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDeadLetterV1Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDeadLetterV1ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDeadLetterV1ResponseValidationError{}
+
+// Validate checks the field values on RetryDeadLetterV1Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *RetryDeadLetterV1Request) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.GetId() <= 0 {
+		return RetryDeadLetterV1RequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+	}
+
+	if m.GetName() != "" {
+
+		if l := utf8.RuneCountInString(m.GetName()); l < 3 || l > 100 {
+			return RetryDeadLetterV1RequestValidationError{
+				field:  "Name",
+				reason: "value length must be between 3 and 100 runes, inclusive",
+			}
+		}
+
+	}
+
+	if utf8.RuneCountInString(m.GetDescription()) > 10000 {
+		return RetryDeadLetterV1RequestValidationError{
+			field:  "Description",
+			reason: "value length must be at most 10000 runes",
+		}
+	}
+
+	return nil
+}
+
+// RetryDeadLetterV1RequestValidationError is the validation error returned by
+// RetryDeadLetterV1Request.Validate if the designated constraints aren't met.
+type RetryDeadLetterV1RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RetryDeadLetterV1RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RetryDeadLetterV1RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RetryDeadLetterV1RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RetryDeadLetterV1RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RetryDeadLetterV1RequestValidationError) ErrorName() string {
+	return "RetryDeadLetterV1RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RetryDeadLetterV1RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRetryDeadLetterV1Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RetryDeadLetterV1RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RetryDeadLetterV1RequestValidationError{}
+
+// Validate checks the field values on RetryDeadLetterV1Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *RetryDeadLetterV1Response) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for TeamId
+
+	return nil
+}
+
+// RetryDeadLetterV1ResponseValidationError is the validation error returned by
+// RetryDeadLetterV1Response.Validate if the designated constraints aren't met.
+type RetryDeadLetterV1ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RetryDeadLetterV1ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RetryDeadLetterV1ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RetryDeadLetterV1ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RetryDeadLetterV1ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RetryDeadLetterV1ResponseValidationError) ErrorName() string {
+	return "RetryDeadLetterV1ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RetryDeadLetterV1ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRetryDeadLetterV1Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RetryDeadLetterV1ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RetryDeadLetterV1ResponseValidationError{}
+
+// Validate checks the field values on RemoveDeadLetterV1Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *RemoveDeadLetterV1Request) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.GetId() <= 0 {
+		return RemoveDeadLetterV1RequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+	}
+
+	return nil
+}
+
+// RemoveDeadLetterV1RequestValidationError is the validation error returned by
+// RemoveDeadLetterV1Request.Validate if the designated constraints aren't met.
+type RemoveDeadLetterV1RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveDeadLetterV1RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveDeadLetterV1RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveDeadLetterV1RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveDeadLetterV1RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveDeadLetterV1RequestValidationError) ErrorName() string {
+	return "RemoveDeadLetterV1RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveDeadLetterV1RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveDeadLetterV1Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveDeadLetterV1RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveDeadLetterV1RequestValidationError{}
+
+// Validate checks the field values on RemoveDeadLetterV1Response with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *RemoveDeadLetterV1Response) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	return nil
+}
+
+// RemoveDeadLetterV1ResponseValidationError is the validation error returned
+// by RemoveDeadLetterV1Response.Validate if the designated constraints aren't met.
+type RemoveDeadLetterV1ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveDeadLetterV1ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveDeadLetterV1ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveDeadLetterV1ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveDeadLetterV1ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveDeadLetterV1ResponseValidationError) ErrorName() string {
+	return "RemoveDeadLetterV1ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveDeadLetterV1ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveDeadLetterV1Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveDeadLetterV1ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveDeadLetterV1ResponseValidationError{}
+
+// Validate checks the field values on DeadLetter with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *DeadLetter) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Id
+
+	if v, ok := interface{}(m.GetTeam()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DeadLetterValidationError{
+				field:  "Team",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Error
+
+	// no validation rules for Attempts
+
+	// no validation rules for CreateTime
+
+	// no validation rules for UpdateTime
+
+	return nil
+}
+
+// DeadLetterValidationError is the validation error returned by
+// DeadLetter.Validate if the designated constraints aren't met.
+type DeadLetterValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeadLetterValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeadLetterValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeadLetterValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeadLetterValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeadLetterValidationError) ErrorName() string { return "DeadLetterValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DeadLetterValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeadLetter.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeadLetterValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeadLetterValidationError{}
+
+// Validate checks the field values on Team with the rules defined in the proto
+// definition for this message. If any rules are violated, an error is returned.
+func (m *Team) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.GetId() <= 0 {
+		return TeamValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 3 || l > 100 {
+		return TeamValidationError{
+			field:  "Name",
+			reason: "value length must be between 3 and 100 runes, inclusive",
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetDescription()) > 10000 {
+		return TeamValidationError{
+			field:  "Description",
+			reason: "value length must be at most 10000 runes",
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetExternalId()) > 255 {
+		return TeamValidationError{
+			field:  "ExternalId",
+			reason: "value length must be at most 255 runes",
+		}
+	}
+
+	return nil
+}
+
+// TeamValidationError is the validation error returned by Team.Validate if the
+// designated constraints aren't met.
+type TeamValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TeamValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TeamValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TeamValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TeamValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TeamValidationError) ErrorName() string { return "TeamValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TeamValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTeam.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TeamValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TeamValidationError{}
+
+// Validate checks the field values on UpsertTeamsV1Request_Team with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *UpsertTeamsV1Request_Team) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if l := utf8.RuneCountInString(m.GetExternalId()); l < 1 || l > 255 {
+		return UpsertTeamsV1Request_TeamValidationError{
+			field:  "ExternalId",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 3 || l > 100 {
+		return UpsertTeamsV1Request_TeamValidationError{
+			field:  "Name",
+			reason: "value length must be between 3 and 100 runes, inclusive",
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetDescription()) > 10000 {
+		return UpsertTeamsV1Request_TeamValidationError{
+			field:  "Description",
+			reason: "value length must be at most 10000 runes",
+		}
+	}
+
+	return nil
+}
+
+// UpsertTeamsV1Request_TeamValidationError is the validation error returned by
+// UpsertTeamsV1Request_Team.Validate if the designated constraints aren't met.
+type UpsertTeamsV1Request_TeamValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpsertTeamsV1Request_TeamValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpsertTeamsV1Request_TeamValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpsertTeamsV1Request_TeamValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpsertTeamsV1Request_TeamValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpsertTeamsV1Request_TeamValidationError) ErrorName() string {
+	return "UpsertTeamsV1Request_TeamValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpsertTeamsV1Request_TeamValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpsertTeamsV1Request_Team.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpsertTeamsV1Request_TeamValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpsertTeamsV1Request_TeamValidationError{}
+
+// Validate checks the field values on UpsertTeamsV1Response_Result with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *UpsertTeamsV1Response_Result) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Id
+
+	// no validation rules for ExternalId
+
+	// no validation rules for Status
+
+	return nil
+}
+
+// UpsertTeamsV1Response_ResultValidationError is the validation error returned
+// by UpsertTeamsV1Response_Result.Validate if the designated constraints
+// aren't met.
+type UpsertTeamsV1Response_ResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpsertTeamsV1Response_ResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpsertTeamsV1Response_ResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpsertTeamsV1Response_ResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpsertTeamsV1Response_ResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpsertTeamsV1Response_ResultValidationError) ErrorName() string {
+	return "UpsertTeamsV1Response_ResultValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpsertTeamsV1Response_ResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpsertTeamsV1Response_Result.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpsertTeamsV1Response_ResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpsertTeamsV1Response_ResultValidationError{}
+
+// Validate checks the field values on SearchTeamV1Response_Hit with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *SearchTeamV1Response_Hit) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetTeam()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SearchTeamV1Response_HitValidationError{
+				field:  "Team",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Score
+
+	return nil
+}
+
+// SearchTeamV1Response_HitValidationError is the validation error returned by
+// SearchTeamV1Response_Hit.Validate if the designated constraints aren't met.
+type SearchTeamV1Response_HitValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchTeamV1Response_HitValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchTeamV1Response_HitValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchTeamV1Response_HitValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchTeamV1Response_HitValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchTeamV1Response_HitValidationError) ErrorName() string {
+	return "SearchTeamV1Response_HitValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchTeamV1Response_HitValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchTeamV1Response_Hit.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchTeamV1Response_HitValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchTeamV1Response_HitValidationError{}
@@ -4,6 +4,7 @@ package ocp_team_api
 
 import (
 	context "context"
+	longrunning "google.golang.org/genproto/googleapis/longrunning"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -20,11 +21,25 @@ const _ = grpc.SupportPackageIsVersion7
 type OcpTeamApiClient interface {
 	CreateTeamV1(ctx context.Context, in *CreateTeamV1Request, opts ...grpc.CallOption) (*CreateTeamV1Response, error)
 	MultiCreateTeamV1(ctx context.Context, in *MultiCreateTeamV1Request, opts ...grpc.CallOption) (*MultiCreateTeamV1Response, error)
+	UpsertTeamsV1(ctx context.Context, in *UpsertTeamsV1Request, opts ...grpc.CallOption) (*UpsertTeamsV1Response, error)
 	GetTeamV1(ctx context.Context, in *GetTeamV1Request, opts ...grpc.CallOption) (*GetTeamV1Response, error)
 	ListTeamsV1(ctx context.Context, in *ListTeamsV1Request, opts ...grpc.CallOption) (*ListTeamsV1Response, error)
 	RemoveTeamV1(ctx context.Context, in *RemoveTeamV1Request, opts ...grpc.CallOption) (*RemoveTeamV1Response, error)
 	UpdateTeamV1(ctx context.Context, in *UpdateTeamV1Request, opts ...grpc.CallOption) (*UpdateTeamV1Response, error)
 	SearchTeamsV1(ctx context.Context, in *SearchTeamV1Request, opts ...grpc.CallOption) (*SearchTeamV1Response, error)
+	SuggestTeamsV1(ctx context.Context, in *SuggestTeamsV1Request, opts ...grpc.CallOption) (*SuggestTeamsV1Response, error)
+	CreateTeamAsyncV1(ctx context.Context, in *CreateTeamV1Request, opts ...grpc.CallOption) (*longrunning.Operation, error)
+	MultiCreateTeamAsyncV1(ctx context.Context, in *MultiCreateTeamV1Request, opts ...grpc.CallOption) (*longrunning.Operation, error)
+	GetOperationV1(ctx context.Context, in *GetOperationV1Request, opts ...grpc.CallOption) (*longrunning.Operation, error)
+	ListOperationsV1(ctx context.Context, in *ListOperationsV1Request, opts ...grpc.CallOption) (*ListOperationsV1Response, error)
+	CreateWebhookV1(ctx context.Context, in *CreateWebhookV1Request, opts ...grpc.CallOption) (*CreateWebhookV1Response, error)
+	ListWebhooksV1(ctx context.Context, in *ListWebhooksV1Request, opts ...grpc.CallOption) (*ListWebhooksV1Response, error)
+	RemoveWebhookV1(ctx context.Context, in *RemoveWebhookV1Request, opts ...grpc.CallOption) (*RemoveWebhookV1Response, error)
+	ListWebhookDeliveriesV1(ctx context.Context, in *ListWebhookDeliveriesV1Request, opts ...grpc.CallOption) (*ListWebhookDeliveriesV1Response, error)
+	ListDeadLettersV1(ctx context.Context, in *ListDeadLettersV1Request, opts ...grpc.CallOption) (*ListDeadLettersV1Response, error)
+	GetDeadLetterV1(ctx context.Context, in *GetDeadLetterV1Request, opts ...grpc.CallOption) (*GetDeadLetterV1Response, error)
+	RetryDeadLetterV1(ctx context.Context, in *RetryDeadLetterV1Request, opts ...grpc.CallOption) (*RetryDeadLetterV1Response, error)
+	RemoveDeadLetterV1(ctx context.Context, in *RemoveDeadLetterV1Request, opts ...grpc.CallOption) (*RemoveDeadLetterV1Response, error)
 }
 
 type ocpTeamApiClient struct {
@@ -53,6 +68,15 @@ func (c *ocpTeamApiClient) MultiCreateTeamV1(ctx context.Context, in *MultiCreat
 	return out, nil
 }
 
+func (c *ocpTeamApiClient) UpsertTeamsV1(ctx context.Context, in *UpsertTeamsV1Request, opts ...grpc.CallOption) (*UpsertTeamsV1Response, error) {
+	out := new(UpsertTeamsV1Response)
+	err := c.cc.Invoke(ctx, "/ocp.team.api.OcpTeamApi/UpsertTeamsV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *ocpTeamApiClient) GetTeamV1(ctx context.Context, in *GetTeamV1Request, opts ...grpc.CallOption) (*GetTeamV1Response, error) {
 	out := new(GetTeamV1Response)
 	err := c.cc.Invoke(ctx, "/ocp.team.api.OcpTeamApi/GetTeamV1", in, out, opts...)
@@ -98,17 +122,148 @@ func (c *ocpTeamApiClient) SearchTeamsV1(ctx context.Context, in *SearchTeamV1Re
 	return out, nil
 }
 
+func (c *ocpTeamApiClient) SuggestTeamsV1(ctx context.Context, in *SuggestTeamsV1Request, opts ...grpc.CallOption) (*SuggestTeamsV1Response, error) {
+	out := new(SuggestTeamsV1Response)
+	err := c.cc.Invoke(ctx, "/ocp.team.api.OcpTeamApi/SuggestTeamsV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ocpTeamApiClient) CreateTeamAsyncV1(ctx context.Context, in *CreateTeamV1Request, opts ...grpc.CallOption) (*longrunning.Operation, error) {
+	out := new(longrunning.Operation)
+	err := c.cc.Invoke(ctx, "/ocp.team.api.OcpTeamApi/CreateTeamAsyncV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ocpTeamApiClient) MultiCreateTeamAsyncV1(ctx context.Context, in *MultiCreateTeamV1Request, opts ...grpc.CallOption) (*longrunning.Operation, error) {
+	out := new(longrunning.Operation)
+	err := c.cc.Invoke(ctx, "/ocp.team.api.OcpTeamApi/MultiCreateTeamAsyncV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ocpTeamApiClient) GetOperationV1(ctx context.Context, in *GetOperationV1Request, opts ...grpc.CallOption) (*longrunning.Operation, error) {
+	out := new(longrunning.Operation)
+	err := c.cc.Invoke(ctx, "/ocp.team.api.OcpTeamApi/GetOperationV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ocpTeamApiClient) ListOperationsV1(ctx context.Context, in *ListOperationsV1Request, opts ...grpc.CallOption) (*ListOperationsV1Response, error) {
+	out := new(ListOperationsV1Response)
+	err := c.cc.Invoke(ctx, "/ocp.team.api.OcpTeamApi/ListOperationsV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ocpTeamApiClient) CreateWebhookV1(ctx context.Context, in *CreateWebhookV1Request, opts ...grpc.CallOption) (*CreateWebhookV1Response, error) {
+	out := new(CreateWebhookV1Response)
+	err := c.cc.Invoke(ctx, "/ocp.team.api.OcpTeamApi/CreateWebhookV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ocpTeamApiClient) ListWebhooksV1(ctx context.Context, in *ListWebhooksV1Request, opts ...grpc.CallOption) (*ListWebhooksV1Response, error) {
+	out := new(ListWebhooksV1Response)
+	err := c.cc.Invoke(ctx, "/ocp.team.api.OcpTeamApi/ListWebhooksV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ocpTeamApiClient) RemoveWebhookV1(ctx context.Context, in *RemoveWebhookV1Request, opts ...grpc.CallOption) (*RemoveWebhookV1Response, error) {
+	out := new(RemoveWebhookV1Response)
+	err := c.cc.Invoke(ctx, "/ocp.team.api.OcpTeamApi/RemoveWebhookV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ocpTeamApiClient) ListWebhookDeliveriesV1(ctx context.Context, in *ListWebhookDeliveriesV1Request, opts ...grpc.CallOption) (*ListWebhookDeliveriesV1Response, error) {
+	out := new(ListWebhookDeliveriesV1Response)
+	err := c.cc.Invoke(ctx, "/ocp.team.api.OcpTeamApi/ListWebhookDeliveriesV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ocpTeamApiClient) ListDeadLettersV1(ctx context.Context, in *ListDeadLettersV1Request, opts ...grpc.CallOption) (*ListDeadLettersV1Response, error) {
+	out := new(ListDeadLettersV1Response)
+	err := c.cc.Invoke(ctx, "/ocp.team.api.OcpTeamApi/ListDeadLettersV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ocpTeamApiClient) GetDeadLetterV1(ctx context.Context, in *GetDeadLetterV1Request, opts ...grpc.CallOption) (*GetDeadLetterV1Response, error) {
+	out := new(GetDeadLetterV1Response)
+	err := c.cc.Invoke(ctx, "/ocp.team.api.OcpTeamApi/GetDeadLetterV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ocpTeamApiClient) RetryDeadLetterV1(ctx context.Context, in *RetryDeadLetterV1Request, opts ...grpc.CallOption) (*RetryDeadLetterV1Response, error) {
+	out := new(RetryDeadLetterV1Response)
+	err := c.cc.Invoke(ctx, "/ocp.team.api.OcpTeamApi/RetryDeadLetterV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ocpTeamApiClient) RemoveDeadLetterV1(ctx context.Context, in *RemoveDeadLetterV1Request, opts ...grpc.CallOption) (*RemoveDeadLetterV1Response, error) {
+	out := new(RemoveDeadLetterV1Response)
+	err := c.cc.Invoke(ctx, "/ocp.team.api.OcpTeamApi/RemoveDeadLetterV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OcpTeamApiServer is the server API for OcpTeamApi service.
 // All implementations must embed UnimplementedOcpTeamApiServer
 // for forward compatibility
 type OcpTeamApiServer interface {
 	CreateTeamV1(context.Context, *CreateTeamV1Request) (*CreateTeamV1Response, error)
 	MultiCreateTeamV1(context.Context, *MultiCreateTeamV1Request) (*MultiCreateTeamV1Response, error)
+	UpsertTeamsV1(context.Context, *UpsertTeamsV1Request) (*UpsertTeamsV1Response, error)
 	GetTeamV1(context.Context, *GetTeamV1Request) (*GetTeamV1Response, error)
 	ListTeamsV1(context.Context, *ListTeamsV1Request) (*ListTeamsV1Response, error)
 	RemoveTeamV1(context.Context, *RemoveTeamV1Request) (*RemoveTeamV1Response, error)
 	UpdateTeamV1(context.Context, *UpdateTeamV1Request) (*UpdateTeamV1Response, error)
 	SearchTeamsV1(context.Context, *SearchTeamV1Request) (*SearchTeamV1Response, error)
+	SuggestTeamsV1(context.Context, *SuggestTeamsV1Request) (*SuggestTeamsV1Response, error)
+	CreateTeamAsyncV1(context.Context, *CreateTeamV1Request) (*longrunning.Operation, error)
+	MultiCreateTeamAsyncV1(context.Context, *MultiCreateTeamV1Request) (*longrunning.Operation, error)
+	GetOperationV1(context.Context, *GetOperationV1Request) (*longrunning.Operation, error)
+	ListOperationsV1(context.Context, *ListOperationsV1Request) (*ListOperationsV1Response, error)
+	CreateWebhookV1(context.Context, *CreateWebhookV1Request) (*CreateWebhookV1Response, error)
+	ListWebhooksV1(context.Context, *ListWebhooksV1Request) (*ListWebhooksV1Response, error)
+	RemoveWebhookV1(context.Context, *RemoveWebhookV1Request) (*RemoveWebhookV1Response, error)
+	ListWebhookDeliveriesV1(context.Context, *ListWebhookDeliveriesV1Request) (*ListWebhookDeliveriesV1Response, error)
+	ListDeadLettersV1(context.Context, *ListDeadLettersV1Request) (*ListDeadLettersV1Response, error)
+	GetDeadLetterV1(context.Context, *GetDeadLetterV1Request) (*GetDeadLetterV1Response, error)
+	RetryDeadLetterV1(context.Context, *RetryDeadLetterV1Request) (*RetryDeadLetterV1Response, error)
+	RemoveDeadLetterV1(context.Context, *RemoveDeadLetterV1Request) (*RemoveDeadLetterV1Response, error)
 	mustEmbedUnimplementedOcpTeamApiServer()
 }
 
@@ -122,6 +277,9 @@ func (UnimplementedOcpTeamApiServer) CreateTeamV1(context.Context, *CreateTeamV1
 func (UnimplementedOcpTeamApiServer) MultiCreateTeamV1(context.Context, *MultiCreateTeamV1Request) (*MultiCreateTeamV1Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MultiCreateTeamV1 not implemented")
 }
+func (UnimplementedOcpTeamApiServer) UpsertTeamsV1(context.Context, *UpsertTeamsV1Request) (*UpsertTeamsV1Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertTeamsV1 not implemented")
+}
 func (UnimplementedOcpTeamApiServer) GetTeamV1(context.Context, *GetTeamV1Request) (*GetTeamV1Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTeamV1 not implemented")
 }
@@ -137,6 +295,45 @@ func (UnimplementedOcpTeamApiServer) UpdateTeamV1(context.Context, *UpdateTeamV1
 func (UnimplementedOcpTeamApiServer) SearchTeamsV1(context.Context, *SearchTeamV1Request) (*SearchTeamV1Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SearchTeamsV1 not implemented")
 }
+func (UnimplementedOcpTeamApiServer) SuggestTeamsV1(context.Context, *SuggestTeamsV1Request) (*SuggestTeamsV1Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuggestTeamsV1 not implemented")
+}
+func (UnimplementedOcpTeamApiServer) CreateTeamAsyncV1(context.Context, *CreateTeamV1Request) (*longrunning.Operation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTeamAsyncV1 not implemented")
+}
+func (UnimplementedOcpTeamApiServer) MultiCreateTeamAsyncV1(context.Context, *MultiCreateTeamV1Request) (*longrunning.Operation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultiCreateTeamAsyncV1 not implemented")
+}
+func (UnimplementedOcpTeamApiServer) GetOperationV1(context.Context, *GetOperationV1Request) (*longrunning.Operation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOperationV1 not implemented")
+}
+func (UnimplementedOcpTeamApiServer) ListOperationsV1(context.Context, *ListOperationsV1Request) (*ListOperationsV1Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOperationsV1 not implemented")
+}
+func (UnimplementedOcpTeamApiServer) CreateWebhookV1(context.Context, *CreateWebhookV1Request) (*CreateWebhookV1Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWebhookV1 not implemented")
+}
+func (UnimplementedOcpTeamApiServer) ListWebhooksV1(context.Context, *ListWebhooksV1Request) (*ListWebhooksV1Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhooksV1 not implemented")
+}
+func (UnimplementedOcpTeamApiServer) RemoveWebhookV1(context.Context, *RemoveWebhookV1Request) (*RemoveWebhookV1Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveWebhookV1 not implemented")
+}
+func (UnimplementedOcpTeamApiServer) ListWebhookDeliveriesV1(context.Context, *ListWebhookDeliveriesV1Request) (*ListWebhookDeliveriesV1Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhookDeliveriesV1 not implemented")
+}
+func (UnimplementedOcpTeamApiServer) ListDeadLettersV1(context.Context, *ListDeadLettersV1Request) (*ListDeadLettersV1Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeadLettersV1 not implemented")
+}
+func (UnimplementedOcpTeamApiServer) GetDeadLetterV1(context.Context, *GetDeadLetterV1Request) (*GetDeadLetterV1Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeadLetterV1 not implemented")
+}
+func (UnimplementedOcpTeamApiServer) RetryDeadLetterV1(context.Context, *RetryDeadLetterV1Request) (*RetryDeadLetterV1Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryDeadLetterV1 not implemented")
+}
+func (UnimplementedOcpTeamApiServer) RemoveDeadLetterV1(context.Context, *RemoveDeadLetterV1Request) (*RemoveDeadLetterV1Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveDeadLetterV1 not implemented")
+}
 func (UnimplementedOcpTeamApiServer) mustEmbedUnimplementedOcpTeamApiServer() {}
 
 // UnsafeOcpTeamApiServer may be embedded to opt out of forward compatibility for this service.
@@ -186,6 +383,24 @@ func _OcpTeamApi_MultiCreateTeamV1_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OcpTeamApi_UpsertTeamsV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertTeamsV1Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OcpTeamApiServer).UpsertTeamsV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocp.team.api.OcpTeamApi/UpsertTeamsV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OcpTeamApiServer).UpsertTeamsV1(ctx, req.(*UpsertTeamsV1Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _OcpTeamApi_GetTeamV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetTeamV1Request)
 	if err := dec(in); err != nil {
@@ -276,6 +491,240 @@ func _OcpTeamApi_SearchTeamsV1_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OcpTeamApi_SuggestTeamsV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestTeamsV1Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OcpTeamApiServer).SuggestTeamsV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocp.team.api.OcpTeamApi/SuggestTeamsV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OcpTeamApiServer).SuggestTeamsV1(ctx, req.(*SuggestTeamsV1Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OcpTeamApi_CreateTeamAsyncV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTeamV1Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OcpTeamApiServer).CreateTeamAsyncV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocp.team.api.OcpTeamApi/CreateTeamAsyncV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OcpTeamApiServer).CreateTeamAsyncV1(ctx, req.(*CreateTeamV1Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OcpTeamApi_MultiCreateTeamAsyncV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MultiCreateTeamV1Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OcpTeamApiServer).MultiCreateTeamAsyncV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocp.team.api.OcpTeamApi/MultiCreateTeamAsyncV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OcpTeamApiServer).MultiCreateTeamAsyncV1(ctx, req.(*MultiCreateTeamV1Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OcpTeamApi_GetOperationV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOperationV1Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OcpTeamApiServer).GetOperationV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocp.team.api.OcpTeamApi/GetOperationV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OcpTeamApiServer).GetOperationV1(ctx, req.(*GetOperationV1Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OcpTeamApi_ListOperationsV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOperationsV1Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OcpTeamApiServer).ListOperationsV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocp.team.api.OcpTeamApi/ListOperationsV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OcpTeamApiServer).ListOperationsV1(ctx, req.(*ListOperationsV1Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OcpTeamApi_CreateWebhookV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWebhookV1Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OcpTeamApiServer).CreateWebhookV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocp.team.api.OcpTeamApi/CreateWebhookV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OcpTeamApiServer).CreateWebhookV1(ctx, req.(*CreateWebhookV1Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OcpTeamApi_ListWebhooksV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhooksV1Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OcpTeamApiServer).ListWebhooksV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocp.team.api.OcpTeamApi/ListWebhooksV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OcpTeamApiServer).ListWebhooksV1(ctx, req.(*ListWebhooksV1Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OcpTeamApi_RemoveWebhookV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveWebhookV1Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OcpTeamApiServer).RemoveWebhookV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocp.team.api.OcpTeamApi/RemoveWebhookV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OcpTeamApiServer).RemoveWebhookV1(ctx, req.(*RemoveWebhookV1Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OcpTeamApi_ListWebhookDeliveriesV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhookDeliveriesV1Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OcpTeamApiServer).ListWebhookDeliveriesV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocp.team.api.OcpTeamApi/ListWebhookDeliveriesV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OcpTeamApiServer).ListWebhookDeliveriesV1(ctx, req.(*ListWebhookDeliveriesV1Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OcpTeamApi_ListDeadLettersV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeadLettersV1Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OcpTeamApiServer).ListDeadLettersV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocp.team.api.OcpTeamApi/ListDeadLettersV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OcpTeamApiServer).ListDeadLettersV1(ctx, req.(*ListDeadLettersV1Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OcpTeamApi_GetDeadLetterV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeadLetterV1Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OcpTeamApiServer).GetDeadLetterV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocp.team.api.OcpTeamApi/GetDeadLetterV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OcpTeamApiServer).GetDeadLetterV1(ctx, req.(*GetDeadLetterV1Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OcpTeamApi_RetryDeadLetterV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetryDeadLetterV1Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OcpTeamApiServer).RetryDeadLetterV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocp.team.api.OcpTeamApi/RetryDeadLetterV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OcpTeamApiServer).RetryDeadLetterV1(ctx, req.(*RetryDeadLetterV1Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OcpTeamApi_RemoveDeadLetterV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveDeadLetterV1Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OcpTeamApiServer).RemoveDeadLetterV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocp.team.api.OcpTeamApi/RemoveDeadLetterV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OcpTeamApiServer).RemoveDeadLetterV1(ctx, req.(*RemoveDeadLetterV1Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // OcpTeamApi_ServiceDesc is the grpc.ServiceDesc for OcpTeamApi service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -291,6 +740,10 @@ var OcpTeamApi_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "MultiCreateTeamV1",
 			Handler:    _OcpTeamApi_MultiCreateTeamV1_Handler,
 		},
+		{
+			MethodName: "UpsertTeamsV1",
+			Handler:    _OcpTeamApi_UpsertTeamsV1_Handler,
+		},
 		{
 			MethodName: "GetTeamV1",
 			Handler:    _OcpTeamApi_GetTeamV1_Handler,
@@ -311,6 +764,58 @@ var OcpTeamApi_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SearchTeamsV1",
 			Handler:    _OcpTeamApi_SearchTeamsV1_Handler,
 		},
+		{
+			MethodName: "SuggestTeamsV1",
+			Handler:    _OcpTeamApi_SuggestTeamsV1_Handler,
+		},
+		{
+			MethodName: "CreateTeamAsyncV1",
+			Handler:    _OcpTeamApi_CreateTeamAsyncV1_Handler,
+		},
+		{
+			MethodName: "MultiCreateTeamAsyncV1",
+			Handler:    _OcpTeamApi_MultiCreateTeamAsyncV1_Handler,
+		},
+		{
+			MethodName: "GetOperationV1",
+			Handler:    _OcpTeamApi_GetOperationV1_Handler,
+		},
+		{
+			MethodName: "ListOperationsV1",
+			Handler:    _OcpTeamApi_ListOperationsV1_Handler,
+		},
+		{
+			MethodName: "CreateWebhookV1",
+			Handler:    _OcpTeamApi_CreateWebhookV1_Handler,
+		},
+		{
+			MethodName: "ListWebhooksV1",
+			Handler:    _OcpTeamApi_ListWebhooksV1_Handler,
+		},
+		{
+			MethodName: "RemoveWebhookV1",
+			Handler:    _OcpTeamApi_RemoveWebhookV1_Handler,
+		},
+		{
+			MethodName: "ListWebhookDeliveriesV1",
+			Handler:    _OcpTeamApi_ListWebhookDeliveriesV1_Handler,
+		},
+		{
+			MethodName: "ListDeadLettersV1",
+			Handler:    _OcpTeamApi_ListDeadLettersV1_Handler,
+		},
+		{
+			MethodName: "GetDeadLetterV1",
+			Handler:    _OcpTeamApi_GetDeadLetterV1_Handler,
+		},
+		{
+			MethodName: "RetryDeadLetterV1",
+			Handler:    _OcpTeamApi_RetryDeadLetterV1_Handler,
+		},
+		{
+			MethodName: "RemoveDeadLetterV1",
+			Handler:    _OcpTeamApi_RemoveDeadLetterV1_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/ocp-team-api/ocp-team-api.proto",